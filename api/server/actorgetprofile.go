@@ -29,12 +29,6 @@ func (s *Server) getProfile(ctx context.Context, actor string) (*vylet.ActorDefs
 	if err != nil {
 		return nil, fmt.Errorf("error getting profile: %w", err)
 	}
-	if resp.Error != nil {
-		if client.IsNotFoundError(resp.Error) {
-			return nil, ErrDatabaseNotFound
-		}
-		return nil, fmt.Errorf("error getting profile: %s", *resp.Error)
-	}
 
 	return &vylet.ActorDefs_ProfileView{
 		Did:         did,
@@ -61,12 +55,6 @@ func (s *Server) getProfileBasic(ctx context.Context, actor string) (*vylet.Acto
 	if err != nil {
 		return nil, fmt.Errorf("error getting profile: %w", err)
 	}
-	if resp.Error != nil {
-		if client.IsNotFoundError(resp.Error) {
-			return nil, ErrDatabaseNotFound
-		}
-		return nil, fmt.Errorf("error getting profile: %s", *resp.Error)
-	}
 
 	return &vylet.ActorDefs_ProfileViewBasic{
 		Did:         did,
@@ -88,10 +76,6 @@ func (s *Server) HandleActorGetProfile(e echo.Context, input *handlers.ActorGetP
 	ctx := e.Request().Context()
 	logger := s.logger.With("name", "HandleActorGetProfile")
 
-	if input.Actor == "" {
-		return nil, NewValidationError("actor", "actor parameter is required")
-	}
-
 	logger = logger.With("actor", input.Actor)
 
 	profile, err := s.getProfile(ctx, input.Actor)
@@ -99,7 +83,7 @@ func (s *Server) HandleActorGetProfile(e echo.Context, input *handlers.ActorGetP
 		if errors.Is(err, ErrActorNotValid) {
 			return nil, NewValidationError("actor", "actor parameter must be a valid DID or handle")
 		}
-		if errors.Is(err, ErrDatabaseNotFound) {
+		if client.IsNotFoundError(err) {
 			return nil, ErrNotFound
 		}
 		logger.Error("error getting profile", "err", err)
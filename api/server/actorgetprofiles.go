@@ -19,11 +19,6 @@ func (s *Server) getProfiles(ctx context.Context, dids []string) (map[string]*vy
 	if err != nil {
 		return nil, fmt.Errorf("error getting profiles: %w", err)
 	}
-	if resp.Error != nil {
-		if *resp.Error != "not found" {
-			return nil, fmt.Errorf("failed to get profiles: %s", *resp.Error)
-		}
-	}
 
 	profiles := make(map[string]*vylet.ActorDefs_ProfileView)
 	var wg sync.WaitGroup
@@ -63,11 +58,6 @@ func (s *Server) getProfilesBasic(ctx context.Context, dids []string) (map[strin
 	if err != nil {
 		return nil, fmt.Errorf("error getting profiles: %w", err)
 	}
-	if resp.Error != nil {
-		if *resp.Error != "not found" {
-			return nil, fmt.Errorf("failed to get profiles: %s", *resp.Error)
-		}
-	}
 
 	profiles := make(map[string]*vylet.ActorDefs_ProfileViewBasic)
 	var wg sync.WaitGroup
@@ -99,6 +89,32 @@ func (s *Server) getProfilesBasic(ctx context.Context, dids []string) (map[strin
 	return profiles, nil
 }
 
+// Returns the viewer's follow state with each of the given accounts. Accounts with no follow in either direction
+// are omitted, as is everything when there is no viewer.
+func (s *Server) getViewerStates(ctx context.Context, viewer string, dids []string) (map[string]*vylet.ActorDefs_ViewerState, error) {
+	viewerStates := make(map[string]*vylet.ActorDefs_ViewerState)
+	if viewer == "" || len(dids) == 0 {
+		return viewerStates, nil
+	}
+
+	resp, err := s.client.Follow.GetFollowRelationships(ctx, &vyletdatabase.GetFollowRelationshipsRequest{
+		Did:       viewer,
+		OtherDids: dids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting follow relationships: %w", err)
+	}
+
+	for did, rel := range resp.Relationships {
+		viewerStates[did] = &vylet.ActorDefs_ViewerState{
+			Following:  rel.Following,
+			FollowedBy: rel.FollowedBy,
+		}
+	}
+
+	return viewerStates, nil
+}
+
 func (s *Server) ActorGetProfilesRequiresAuth() bool {
 	return false
 }
@@ -107,14 +123,6 @@ func (s *Server) HandleActorGetProfiles(e echo.Context, input *handlers.ActorGet
 	ctx := e.Request().Context()
 	logger := s.logger.With("name", "HandleActorGetProfiles")
 
-	if len(input.Dids) == 0 {
-		return nil, NewValidationError("dids", "at least one DID is required")
-	}
-
-	if len(input.Dids) > 25 {
-		return nil, NewValidationError("dids", "at most 25 DIDs may be supplied")
-	}
-
 	logger = logger.With("dids", input.Dids)
 
 	profiles, err := s.getProfiles(ctx, input.Dids)
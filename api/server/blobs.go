@@ -52,22 +52,22 @@ func (s *Server) handleGetBlob(e echo.Context) error {
 		Did: did,
 		Cid: cid,
 	})
+	if client.IsNotFoundError(err) {
+		return ErrNotFound
+	}
 	if err != nil {
 		logger.Error("error getting blob ref from database", "did", did, "cid", cid, "err", err)
 		return ErrInternalServerErr
 	}
 
-	if resp.Error != nil {
-		if client.IsNotFoundError(resp.Error) {
-			return ErrNotFound
-		}
-		logger.Error("error getting blob ref", "did", did, "cid", cid, "error", *resp.Error)
-		return ErrInternalServerErr
-	}
-
 	// Check if blob is taken down
 	if resp.BlobRef.TakenDown {
-		return echo.NewHTTPError(http.StatusGone, "blob has been taken down")
+		return NewXRPCError(http.StatusGone, "BlobTakenDown", "blob has been taken down")
+	}
+
+	// Orphaned blobs are no longer used by any record
+	if resp.BlobRef.Orphaned {
+		return NewXRPCError(http.StatusGone, "BlobOrphaned", "blob is no longer referenced")
 	}
 
 	// Resolve PDS endpoint from DID
@@ -1,31 +1,35 @@
 package server
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/handlers"
 )
 
 var (
-	ErrDatabaseNotFound  = errors.New("not found")
-	ErrInternalServerErr = echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
-	ErrInvalidInput      = echo.NewHTTPError(http.StatusBadRequest, "invalid input")
-	ErrNotFound          = echo.NewHTTPError(http.StatusNotFound, "not found")
-	ErrUnauthorized      = echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	ErrInternalServerErr = NewXRPCError(http.StatusInternalServerError, handlers.ErrorInternalServerError, "internal server error")
+	ErrInvalidCursor     = NewXRPCError(http.StatusBadRequest, handlers.ErrorInvalidRequest, "invalid cursor")
+	ErrNotFound          = NewXRPCError(http.StatusNotFound, handlers.ErrorNotFound, "not found")
+	ErrUnauthorized      = NewXRPCError(http.StatusUnauthorized, handlers.ErrorAuthRequired, "unauthorized")
 )
 
-type ValidationError struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
+// The error types are generated alongside the handlers, which return them for requests that fail binding or
+// lexicon validation before reaching the server. Hand-written errors use the same types so that clients only
+// see one shape.
+type XRPCError = handlers.XRPCError
+type ValidationError = handlers.ValidationError
+type ValidationErrors = handlers.ValidationErrors
+
+// The body written for every error response. Errors holds the individual failures of a validation error.
+type xrpcErrorBody struct {
+	Error   string            `json:"error"`
+	Message string            `json:"message,omitempty"`
+	Errors  []ValidationError `json:"errors,omitempty"`
 }
 
-type ValidationErrors struct {
-	Errors []ValidationError `json:"errors"`
-}
-
-func (v ValidationErrors) Error() string {
-	return "validation failed"
+func NewXRPCError(code int, name, message string) *echo.HTTPError {
+	return handlers.NewXRPCError(code, name, message)
 }
 
 func NewValidationError(field, message string) *echo.HTTPError {
@@ -35,7 +39,5 @@ func NewValidationError(field, message string) *echo.HTTPError {
 }
 
 func NewValidationErrors(errors ...ValidationError) *echo.HTTPError {
-	return echo.NewHTTPError(http.StatusBadRequest, ValidationErrors{
-		Errors: errors,
-	})
+	return handlers.NewValidationErrors(errors...)
 }
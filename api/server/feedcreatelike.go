@@ -0,0 +1,64 @@
+package server
+
+import (
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+func (s *Server) FeedCreateLikeRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleFeedCreateLike(e echo.Context, input *vylet.FeedCreateLike_Input) (*vylet.FeedCreateLike_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	logger := s.logger.With("name", "HandleFeedCreateLike")
+
+	session, err := getPdsSession(e)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	logger = logger.With("did", session.Did)
+
+	if input.Subject == nil {
+		return nil, NewValidationError("subject", "subject is required")
+	}
+
+	if _, err := syntax.ParseATURI(input.Subject.Uri); err != nil {
+		return nil, NewValidationError("subject", "subject URI must be a valid AT-URI")
+	}
+
+	if _, err := syntax.ParseCID(input.Subject.Cid); err != nil {
+		return nil, NewValidationError("subject", "subject CID must be a valid CID")
+	}
+
+	rec := vylet.FeedLike{
+		LexiconTypeID: "app.vylet.feed.like",
+		Subject:       input.Subject,
+		CreatedAt:     syntax.DatetimeNow().String(),
+	}
+
+	pdsClient, err := s.pdsClientForSession(ctx, session)
+	if err != nil {
+		logger.Error("failed to create pds client", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	out, err := comatproto.RepoCreateRecord(ctx, pdsClient, &comatproto.RepoCreateRecord_Input{
+		Repo:       session.Did,
+		Collection: "app.vylet.feed.like",
+		Record:     &lexutil.LexiconTypeDecoder{Val: &rec},
+	})
+	if err != nil {
+		logger.Warn("failed to create like record on pds", "err", err)
+		return nil, pdsErrorToHTTPError(err)
+	}
+
+	return &vylet.FeedCreateLike_Output{
+		Uri: out.Uri,
+		Cid: out.Cid,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	"github.com/labstack/echo/v4"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/vylet"
+	"github.com/vylet-app/go/internal/richtext"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Writes the post straight into the database so that the author can see it before the indexer catches up.
+// The indexer will later upsert the same rows when the commit arrives on the firehose. The uri comes from the PDS,
+// which is only trusted to write posts into the session's own repo.
+func (s *Server) createPostOptimistic(ctx context.Context, did, uri, cid string, rec *vylet.FeedPost) error {
+	aturi, err := syntax.ParseATURI(uri)
+	if err != nil {
+		return fmt.Errorf("failed to parse aturi: %w", err)
+	}
+	if aturi.Authority().String() != did || aturi.Collection().String() != "app.vylet.feed.post" {
+		return fmt.Errorf("pds returned uri %s outside of the session's posts", uri)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, rec.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to parse time from record: %w", err)
+	}
+
+	images := make([]*vyletdatabase.Image, 0, len(rec.Media.MediaImages.Images))
+	for _, img := range rec.Media.MediaImages.Images {
+		dbimg := &vyletdatabase.Image{
+			Cid:  img.Image.Ref.String(),
+			Size: img.Image.Size,
+			Mime: img.Image.MimeType,
+			Alt:  &img.Alt,
+		}
+		if img.AspectRatio != nil {
+			dbimg.Width = &img.AspectRatio.Width
+			dbimg.Height = &img.AspectRatio.Height
+		}
+		images = append(images, dbimg)
+	}
+
+	// Normalized the same way as the indexer, so that the upserted rows match
+	var caption string
+	if rec.Caption != nil {
+		caption = *rec.Caption
+	}
+	facets, _ := richtext.Normalize(ctx, s.directory, caption, rec.Facets)
+
+	req := vyletdatabase.CreatePostRequest{
+		Post: &vyletdatabase.Post{
+			Uri:       uri,
+			Cid:       cid,
+			AuthorDid: aturi.Authority().String(),
+			Images:    images,
+			Caption:   rec.Caption,
+			Facets:    facets,
+			Tags:      richtext.ExtractTags(caption, facets),
+			CreatedAt: timestamppb.New(createdAt),
+		},
+	}
+
+	_, err = s.client.Post.CreatePost(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("failed to create create post request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) FeedCreatePostRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleFeedCreatePost(e echo.Context, input *vylet.FeedCreatePost_Input) (*vylet.FeedCreatePost_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	logger := s.logger.With("name", "HandleFeedCreatePost")
+
+	session, err := getPdsSession(e)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	logger = logger.With("did", session.Did)
+
+	if input.Media == nil || input.Media.MediaImages == nil || len(input.Media.MediaImages.Images) == 0 {
+		return nil, NewValidationError("media", "at least one image is required")
+	}
+
+	for _, img := range input.Media.MediaImages.Images {
+		if img == nil || img.Image == nil {
+			return nil, NewValidationError("media", "all images must reference a blob")
+		}
+	}
+
+	rec := vylet.FeedPost{
+		LexiconTypeID: "app.vylet.feed.post",
+		Caption:       input.Caption,
+		Facets:        input.Facets,
+		Media: &vylet.FeedPost_Media{
+			MediaImages: input.Media.MediaImages,
+		},
+		CreatedAt: syntax.DatetimeNow().String(),
+	}
+
+	if input.Labels != nil {
+		rec.Labels = &vylet.FeedPost_Labels{
+			LabelDefs_SelfLabels: input.Labels.LabelDefs_SelfLabels,
+		}
+	}
+
+	pdsClient, err := s.pdsClientForSession(ctx, session)
+	if err != nil {
+		logger.Error("failed to create pds client", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	out, err := comatproto.RepoCreateRecord(ctx, pdsClient, &comatproto.RepoCreateRecord_Input{
+		Repo:       session.Did,
+		Collection: "app.vylet.feed.post",
+		Record:     &lexutil.LexiconTypeDecoder{Val: &rec},
+	})
+	if err != nil {
+		logger.Warn("failed to create post record on pds", "err", err)
+		return nil, pdsErrorToHTTPError(err)
+	}
+
+	logger = logger.With("uri", out.Uri, "cid", out.Cid)
+
+	if s.optimisticWrites {
+		if err := s.createPostOptimistic(ctx, session.Did, out.Uri, out.Cid, &rec); err != nil {
+			// The record exists on the PDS, so the indexer will still pick it up. Don't fail the request.
+			logger.Error("failed to optimistically write post", "err", err)
+		}
+	}
+
+	return &vylet.FeedCreatePost_Output{
+		Uri: out.Uri,
+		Cid: out.Cid,
+	}, nil
+}
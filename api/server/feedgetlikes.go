@@ -2,17 +2,20 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/database/client"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
 	"github.com/vylet-app/go/generated/handlers"
 	"github.com/vylet-app/go/generated/vylet"
-	"github.com/vylet-app/go/internal/helpers"
+	"golang.org/x/sync/errgroup"
 )
 
-func (s *Server) getLikesBySubject(ctx context.Context, subjectUri string, limit int64, cursor *string) ([]*vylet.FeedGetSubjectLikes_Like, *string, error) {
+func (s *Server) getLikesBySubject(ctx context.Context, subjectUri string, limit int64, cursor *string, viewer string) ([]*vylet.FeedGetSubjectLikes_Like, *string, error) {
 	logger := s.logger.With("name", "getLikesBySubject", "uri", subjectUri)
 
 	resp, err := s.client.Like.GetLikesBySubject(ctx, &vyletdatabase.GetLikesBySubjectRequest{
@@ -24,14 +27,36 @@ func (s *Server) getLikesBySubject(ctx context.Context, subjectUri string, limit
 		return nil, nil, fmt.Errorf("failed to get likes by subject: %w", err)
 	}
 
+	if len(resp.Likes) == 0 {
+		return []*vylet.FeedGetSubjectLikes_Like{}, resp.Cursor, nil
+	}
+
 	dids := make([]string, 0, len(resp.Likes))
 	for _, like := range resp.Likes {
 		dids = append(dids, like.AuthorDid)
 	}
 
-	profiles, err := s.getProfiles(ctx, dids)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get profiles for subject: %w", err)
+	g, gCtx := errgroup.WithContext(ctx)
+	var profiles map[string]*vylet.ActorDefs_ProfileView
+	var viewerStates map[string]*vylet.ActorDefs_ViewerState
+	g.Go(func() error {
+		maybeProfiles, err := s.getProfiles(gCtx, dids)
+		if err != nil {
+			return fmt.Errorf("failed to get profiles for subject: %w", err)
+		}
+		profiles = maybeProfiles
+		return nil
+	})
+	g.Go(func() error {
+		maybeViewerStates, err := s.getViewerStates(gCtx, viewer, dids)
+		if err != nil {
+			return fmt.Errorf("failed to get viewer states for subject: %w", err)
+		}
+		viewerStates = maybeViewerStates
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
 	}
 
 	likes := make([]*vylet.FeedGetSubjectLikes_Like, 0, len(resp.Likes))
@@ -41,8 +66,13 @@ func (s *Server) getLikesBySubject(ctx context.Context, subjectUri string, limit
 			logger.Warn("failed to find profile for like", "did", like.AuthorDid, "uri", like.Uri)
 			continue
 		}
+		if viewerState, ok := viewerStates[like.AuthorDid]; ok {
+			profile.Viewer = viewerState
+		}
 
 		likes = append(likes, &vylet.FeedGetSubjectLikes_Like{
+			Uri:       like.Uri,
+			Cid:       like.Cid,
 			Actor:     profile,
 			CreatedAt: like.CreatedAt.AsTime().Format(time.RFC3339Nano),
 			IndexedAt: like.IndexedAt.AsTime().Format(time.RFC3339Nano),
@@ -58,29 +88,124 @@ func (s *Server) FeedGetSubjectLikesRequiresAuth() bool {
 
 func (s *Server) HandleFeedGetSubjectLikes(e echo.Context, input *handlers.FeedGetSubjectLikesInput) (*vylet.FeedGetSubjectLikes_Output, *echo.HTTPError) {
 	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleFeedGetSubjectLikes", "viewer", viewer)
+
+	logger = logger.With("uri", input.Uri, "includeSubject", *input.IncludeSubject)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	var (
+		likes   []*vylet.FeedGetSubjectLikes_Like
+		cursor  *string
+		subject *vylet.FeedDefs_PostView
+	)
+	g.Go(func() error {
+		var err error
+		likes, cursor, err = s.getLikesBySubject(gCtx, input.Uri, *input.Limit, input.Cursor, viewer)
+		if err != nil {
+			return fmt.Errorf("failed to get subject likes: %w", err)
+		}
+		return nil
+	})
+	if *input.IncludeSubject {
+		g.Go(func() error {
+			postViews, err := s.getPostViews(gCtx, []string{input.Uri}, viewer)
+			if err != nil {
+				// Likes are still returned for subjects that have been deleted or were never indexed
+				if client.IsNotFoundError(err) {
+					return nil
+				}
+				return fmt.Errorf("failed to get subject post view: %w", err)
+			}
+			subject = postViews[input.Uri]
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		if client.IsInvalidCursorError(err) {
+			return nil, ErrInvalidCursor
+		}
+		logger.Error("failed to get subject likes", "err", err)
+		return nil, ErrInternalServerErr
+	}
 
-	logger := s.logger.With("name", "HandleFeedGetSubjectLikes")
+	return &vylet.FeedGetSubjectLikes_Output{
+		Uri:     input.Uri,
+		Likes:   likes,
+		Subject: subject,
+		Cursor:  cursor,
+	}, nil
+}
+
+func (s *Server) FeedGetActorLikesRequiresAuth() bool {
+	return !s.publicActorLikes
+}
+
+func (s *Server) HandleFeedGetActorLikes(e echo.Context, input *handlers.FeedGetActorLikesInput) (*vylet.FeedGetActorLikes_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
 
-	if input.Uri == "" {
-		return nil, NewValidationError("uri", "URI must be provided")
+	logger := s.logger.With("name", "HandleFeedGetActorLikes", "viewer", viewer)
+
+	logger = logger.With("actor", input.Actor, "limit", *input.Limit, "cursor", input.Cursor)
+
+	did, _, err := s.fetchDidHandleFromActor(ctx, input.Actor)
+	if err != nil {
+		if errors.Is(err, ErrActorNotValid) {
+			return nil, NewValidationError("actor", "actor must be a valid DID or handle")
+		}
+		logger.Error("error fetching did and handle", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	if !s.publicActorLikes && did != viewer {
+		return nil, NewXRPCError(http.StatusForbidden, handlers.FeedGetActorLikesErrorRequesterNotActor, "likes may only be listed by the actor themselves")
+	}
+
+	resp, err := s.client.Like.GetLikesByActor(ctx, &vyletdatabase.GetLikesByActorRequest{
+		Did:    did,
+		Limit:  *input.Limit,
+		Cursor: input.Cursor,
+	})
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
+	if err != nil {
+		logger.Error("failed to get likes by actor", "err", err)
+		return nil, ErrInternalServerErr
 	}
 
-	if input.Limit != nil && (*input.Limit < 1 || *input.Limit > 100) {
-		return nil, NewValidationError("limit", "limit must be between 1 and 100")
-	} else if input.Limit == nil {
-		input.Limit = helpers.ToInt64Ptr(25)
+	if len(resp.Likes) == 0 {
+		return &vylet.FeedGetActorLikes_Output{
+			Posts:  []*vylet.FeedDefs_PostView{},
+			Cursor: resp.Cursor,
+		}, nil
 	}
 
-	logger = logger.With("uri", input.Uri)
+	uris := make([]string, 0, len(resp.Likes))
+	for _, like := range resp.Likes {
+		uris = append(uris, like.SubjectUri)
+	}
 
-	likes, cursor, err := s.getLikesBySubject(ctx, input.Uri, *input.Limit, input.Cursor)
+	postViews, err := s.getPostViews(ctx, uris, viewer)
 	if err != nil {
-		logger.Error("failed to get subject likes", "err", err)
+		logger.Error("failed to get post views", "err", err)
 		return nil, ErrInternalServerErr
 	}
 
-	return &vylet.FeedGetSubjectLikes_Output{
-		Likes:  likes,
-		Cursor: cursor,
+	// Keep the order the posts were liked in. Liked posts that have since been deleted are skipped.
+	orderedPostViews := make([]*vylet.FeedDefs_PostView, 0, len(postViews))
+	for _, uri := range uris {
+		postView, ok := postViews[uri]
+		if !ok {
+			continue
+		}
+		orderedPostViews = append(orderedPostViews, postView)
+	}
+
+	return &vylet.FeedGetActorLikes_Output{
+		Posts:  orderedPostViews,
+		Cursor: resp.Cursor,
 	}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/vylet-app/go/generated/handlers"
 	"github.com/vylet-app/go/generated/vylet"
 	"github.com/vylet-app/go/internal/helpers"
+	"github.com/vylet-app/go/internal/richtext"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -25,9 +26,6 @@ func (s *Server) getPosts(ctx context.Context, uris []string) (map[string]*vylet
 	if err != nil {
 		return nil, fmt.Errorf("failed to get post: %w", err)
 	}
-	if client.IsNotFoundError(resp.Error) {
-		return nil, ErrDatabaseNotFound
-	}
 
 	feedPosts := make(map[string]*vylet.FeedPost)
 	for _, post := range resp.Posts {
@@ -68,18 +66,35 @@ func (s *Server) getPosts(ctx context.Context, uris []string) (map[string]*vylet
 			media.MediaImages.Images = append(media.MediaImages.Images, mediaImg)
 		}
 
-		if post.Facets != nil {
-			var facets []*vylet.RichtextFacet
-			if err := json.Unmarshal(post.Facets, &facets); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal post facets: %w", err)
-			}
-			feedPost.Facets = facets
+		facets, err := postFacets(post)
+		if err != nil {
+			s.logger.Error("failed to decode post facets", "uri", post.Uri, "err", err)
 		}
+		feedPost.Facets = facets
 	}
 
 	return feedPosts, nil
 }
 
+// Returns the facets of a post, decoding them from the legacy JSON column for posts that were indexed before facets
+// were stored typed. Legacy facets that fail to decode are omitted rather than failing the whole post.
+func postFacets(post *vyletdatabase.Post) ([]*vylet.RichtextFacet, error) {
+	if len(post.Facets) > 0 {
+		return richtext.ToLexicon(post.Facets), nil
+	}
+
+	if len(post.LegacyFacets) == 0 {
+		return nil, nil
+	}
+
+	var facets []*vylet.RichtextFacet
+	if err := json.Unmarshal(post.LegacyFacets, &facets); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal legacy facets: %w", err)
+	}
+
+	return facets, nil
+}
+
 func (s *Server) getPostViews(ctx context.Context, uris []string, viewer string) (map[string]*vylet.FeedDefs_PostView, error) {
 	resp, err := s.client.Post.GetPosts(ctx, &vyletdatabase.GetPostsRequest{
 		Uris: uris,
@@ -87,9 +102,6 @@ func (s *Server) getPostViews(ctx context.Context, uris []string, viewer string)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get post: %w", err)
 	}
-	if client.IsNotFoundError(resp.Error) {
-		return nil, ErrDatabaseNotFound
-	}
 
 	feedPostViews, err := s.postsToPostViews(ctx, resp.Posts, viewer)
 	if err != nil {
@@ -131,9 +143,6 @@ func (s *Server) postsToPostViews(ctx context.Context, posts map[string]*vyletda
 		if err != nil {
 			return err
 		}
-		if maybeCounts.Error != nil {
-			return fmt.Errorf("failed to get post interaction counts: %s", *maybeCounts.Error)
-		}
 		countsResp = maybeCounts
 		return nil
 	})
@@ -191,14 +200,11 @@ func (s *Server) postsToPostViews(ctx context.Context, posts map[string]*vyletda
 		}
 		postView.Media = &media
 
-		if post.Facets != nil {
-			var facets []*vylet.RichtextFacet
-			if err := json.Unmarshal(post.Facets, &facets); err != nil {
-				logger.Error("failed to unmarshal post facets", "uri", post.Uri, "err", err)
-				continue
-			}
-			postView.Facets = facets
+		facets, err := postFacets(post)
+		if err != nil {
+			logger.Error("failed to decode post facets", "uri", post.Uri, "err", err)
 		}
+		postView.Facets = facets
 
 		feedPostViews[post.Uri] = postView
 	}
@@ -216,19 +222,6 @@ func (s *Server) HandleFeedGetPosts(e echo.Context, input *handlers.FeedGetPosts
 
 	logger := s.logger.With("name", "HandleFeedGetPosts", "viewer", viewer)
 
-	if len(input.Uris) == 0 {
-		return nil, NewValidationError("uris", "must supply at least one AT-URI")
-	}
-
-	if len(input.Uris) > 25 {
-		return nil, NewValidationError("uris", "no more than 25 AT-URIs may be supplied")
-	}
-
-	if allValid, err := helpers.ValidateUris(input.Uris); !allValid {
-		logger.Warn("received invalid URIs", "uris", input.Uris, "err", err)
-		return nil, NewValidationError("uris", "all URIs must be valid AT-URIs")
-	}
-
 	postViews, err := s.getPostViews(ctx, input.Uris, viewer)
 	if err != nil {
 		logger.Error("failed to get posts", "err", err)
@@ -264,12 +257,6 @@ func (s *Server) HandleFeedGetActorPosts(e echo.Context, input *handlers.FeedGet
 
 	logger := s.logger.With("name", "handleGetActorPosts", "viewer", viewer)
 
-	if input.Limit != nil && (*input.Limit < 1 || *input.Limit > 100) {
-		return nil, NewValidationError("limit", "limit must be between 1 and 100")
-	} else if input.Limit == nil {
-		input.Limit = helpers.ToInt64Ptr(25)
-	}
-
 	logger = logger.With("actor", input.Actor, "limit", *input.Limit, "cursor", input.Cursor)
 
 	did, _, err := s.fetchDidHandleFromActor(ctx, input.Actor)
@@ -286,8 +273,11 @@ func (s *Server) HandleFeedGetActorPosts(e echo.Context, input *handlers.FeedGet
 		Limit:  *input.Limit,
 		Cursor: input.Cursor,
 	})
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
 	if err != nil {
-		logger.Error("failed to get posts", "did", did)
+		logger.Error("failed to get posts", "did", did, "err", err)
 		return nil, ErrInternalServerErr
 	}
 
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/database/client"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/handlers"
+	"github.com/vylet-app/go/generated/vylet"
+	"github.com/vylet-app/go/internal/richtext"
+)
+
+// Number of hours counted for each of the trending tag windows allowed by the lexicon
+var trendingTagWindows = map[string]int64{
+	"1h":  1,
+	"6h":  6,
+	"24h": 24,
+}
+
+func (s *Server) FeedGetTagPostsRequiresAuth() bool {
+	return false
+}
+
+func (s *Server) HandleFeedGetTagPosts(e echo.Context, input *handlers.FeedGetTagPostsInput) (*vylet.FeedGetTagPosts_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleFeedGetTagPosts", "viewer", viewer)
+
+	tag, ok := richtext.NormalizeTag(input.Tag)
+	if !ok {
+		return nil, NewValidationError("tag", "tag must be a valid hashtag")
+	}
+
+	logger = logger.With("tag", tag, "limit", *input.Limit, "cursor", input.Cursor)
+
+	resp, err := s.client.Tag.GetPostsByTag(ctx, &vyletdatabase.GetPostsByTagRequest{
+		Tag:    tag,
+		Limit:  *input.Limit,
+		Cursor: input.Cursor,
+	})
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
+	if err != nil {
+		logger.Error("failed to get posts by tag", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	postViews, err := s.postsToPostViews(ctx, resp.Posts, viewer)
+	if err != nil {
+		logger.Error("failed to get post views", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	sortedPostViews := make([]*vylet.FeedDefs_PostView, 0, len(postViews))
+	for _, postView := range postViews {
+		sortedPostViews = append(sortedPostViews, postView)
+	}
+	sort.Slice(sortedPostViews, func(i, j int) bool {
+		return sortedPostViews[i].CreatedAt > sortedPostViews[j].CreatedAt
+	})
+
+	return &vylet.FeedGetTagPosts_Output{
+		Tag:    tag,
+		Posts:  sortedPostViews,
+		Cursor: resp.Cursor,
+	}, nil
+}
+
+func (s *Server) FeedGetTrendingTagsRequiresAuth() bool {
+	return false
+}
+
+func (s *Server) HandleFeedGetTrendingTags(e echo.Context, input *handlers.FeedGetTrendingTagsInput) (*vylet.FeedGetTrendingTags_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+
+	logger := s.logger.With("name", "HandleFeedGetTrendingTags", "window", *input.Window, "limit", *input.Limit)
+
+	windowHours, ok := trendingTagWindows[*input.Window]
+	if !ok {
+		return nil, NewValidationError("window", "window must be one of: 1h, 6h, 24h")
+	}
+
+	resp, err := s.client.Tag.GetTrendingTags(ctx, &vyletdatabase.GetTrendingTagsRequest{
+		WindowHours: windowHours,
+		Limit:       *input.Limit,
+	})
+	if err != nil {
+		logger.Error("failed to get trending tags", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	tags := make([]*vylet.FeedDefs_TrendingTag, 0, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		tags = append(tags, &vylet.FeedDefs_TrendingTag{
+			Tag:   tag.Tag,
+			Count: tag.Count,
+		})
+	}
+
+	return &vylet.FeedGetTrendingTags_Output{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net/http"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/handlers"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+func (s *Server) GraphCreateFollowRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleGraphCreateFollow(e echo.Context, input *vylet.GraphCreateFollow_Input) (*vylet.GraphCreateFollow_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	logger := s.logger.With("name", "HandleGraphCreateFollow")
+
+	session, err := getPdsSession(e)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	logger = logger.With("did", session.Did)
+
+	if input.Subject == "" {
+		return nil, NewValidationError("subject", "subject is required")
+	}
+
+	subject, err := syntax.ParseDID(input.Subject)
+	if err != nil {
+		return nil, NewValidationError("subject", "subject must be a valid DID")
+	}
+
+	if subject.String() == session.Did {
+		return nil, NewXRPCError(http.StatusBadRequest, handlers.GraphCreateFollowErrorSelfFollow, "cannot follow yourself")
+	}
+
+	rec := vylet.GraphFollow{
+		LexiconTypeID: "app.vylet.graph.follow",
+		Subject:       subject.String(),
+		CreatedAt:     syntax.DatetimeNow().String(),
+	}
+
+	pdsClient, err := s.pdsClientForSession(ctx, session)
+	if err != nil {
+		logger.Error("failed to create pds client", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	out, err := comatproto.RepoCreateRecord(ctx, pdsClient, &comatproto.RepoCreateRecord_Input{
+		Repo:       session.Did,
+		Collection: "app.vylet.graph.follow",
+		Record:     &lexutil.LexiconTypeDecoder{Val: &rec},
+	})
+	if err != nil {
+		logger.Warn("failed to create follow record on pds", "err", err)
+		return nil, pdsErrorToHTTPError(err)
+	}
+
+	return &vylet.GraphCreateFollow_Output{
+		Uri: out.Uri,
+		Cid: out.Cid,
+	}, nil
+}
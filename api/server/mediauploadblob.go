@@ -0,0 +1,68 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/bluesky-social/indigo/atproto/atclient"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+func (s *Server) MediaUploadBlobRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleMediaUploadBlob(e echo.Context, input io.Reader) (*vylet.MediaUploadBlob_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	logger := s.logger.With("name", "HandleMediaUploadBlob")
+
+	session, err := getPdsSession(e)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	logger = logger.With("did", session.Did)
+
+	contentType := e.Request().Header.Get("Content-Type")
+	if contentType == "" {
+		return nil, NewValidationError("Content-Type", "a Content-Type header is required")
+	}
+
+	pdsClient, err := s.pdsClientForSession(ctx, session)
+	if err != nil {
+		logger.Error("failed to create pds client", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	// The generated client would send the lexicon's "*/*" encoding as the Content-Type, so build the request by
+	// hand and pass along the type that the client supplied instead.
+	req := atclient.NewAPIRequest(http.MethodPost, "com.atproto.repo.uploadBlob", input)
+	req.Headers.Set("Accept", "application/json")
+	req.Headers.Set("Content-Type", contentType)
+
+	resp, err := pdsClient.Do(ctx, req)
+	if err != nil {
+		logger.Error("failed to upload blob to pds", "err", err)
+		return nil, pdsErrorToHTTPError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var eb atclient.ErrorBody
+		if err := json.NewDecoder(resp.Body).Decode(&eb); err != nil {
+			return nil, pdsErrorToHTTPError(&atclient.APIError{StatusCode: resp.StatusCode})
+		}
+		logger.Warn("pds rejected blob upload", "status", resp.StatusCode, "error", eb.Name, "message", eb.Message)
+		return nil, pdsErrorToHTTPError(eb.APIError(resp.StatusCode))
+	}
+
+	var output vylet.MediaUploadBlob_Output
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		logger.Error("failed to decode upload blob response", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	return &output, nil
+}
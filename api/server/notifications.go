@@ -0,0 +1,151 @@
+package server
+
+import (
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/database/client"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/handlers"
+	"github.com/vylet-app/go/generated/vylet"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func notificationReasonToLexicon(reason vyletdatabase.NotificationReason) string {
+	switch reason {
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_LIKE:
+		return "like"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_FOLLOW:
+		return "follow"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_COMMENT:
+		return "comment"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_MENTION:
+		return "mention"
+	}
+	return ""
+}
+
+func (s *Server) NotificationListNotificationsRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleNotificationListNotifications(e echo.Context, input *handlers.NotificationListNotificationsInput) (*vylet.NotificationListNotifications_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleNotificationListNotifications", "viewer", viewer, "limit", *input.Limit, "cursor", input.Cursor)
+
+	resp, err := s.client.Notification.GetNotifications(ctx, &vyletdatabase.GetNotificationsRequest{
+		Did:    viewer,
+		Limit:  *input.Limit,
+		Cursor: input.Cursor,
+	})
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
+	if err != nil {
+		logger.Error("failed to get notifications", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	dids := make([]string, 0, len(resp.Notifications))
+	addedDids := make(map[string]struct{})
+	for _, notif := range resp.Notifications {
+		if _, ok := addedDids[notif.AuthorDid]; ok {
+			continue
+		}
+		dids = append(dids, notif.AuthorDid)
+		addedDids[notif.AuthorDid] = struct{}{}
+	}
+
+	var profiles map[string]*vylet.ActorDefs_ProfileViewBasic
+	if len(dids) > 0 {
+		profiles, err = s.getProfilesBasic(ctx, dids)
+		if err != nil {
+			logger.Error("failed to get notification authors", "err", err)
+			return nil, ErrInternalServerErr
+		}
+	}
+
+	notifications := make([]*vylet.NotificationDefs_Notification, 0, len(resp.Notifications))
+	for _, notif := range resp.Notifications {
+		profileBasic, ok := profiles[notif.AuthorDid]
+		if !ok {
+			logger.Warn("failed to get profile for notification", "did", notif.AuthorDid, "uri", notif.Uri)
+			continue
+		}
+
+		notifications = append(notifications, &vylet.NotificationDefs_Notification{
+			Uri:           notif.Uri,
+			Cid:           notif.Cid,
+			Author:        profileBasic,
+			Reason:        notificationReasonToLexicon(notif.Reason),
+			ReasonSubject: notif.ReasonSubject,
+			IsRead:        notif.IsRead,
+			CreatedAt:     notif.CreatedAt.AsTime().Format(time.RFC3339Nano),
+			IndexedAt:     notif.IndexedAt.AsTime().Format(time.RFC3339Nano),
+		})
+	}
+
+	output := &vylet.NotificationListNotifications_Output{
+		Notifications: notifications,
+		Cursor:        resp.Cursor,
+	}
+	if resp.SeenAt != nil {
+		seenAt := resp.SeenAt.AsTime().Format(time.RFC3339Nano)
+		output.SeenAt = &seenAt
+	}
+
+	return output, nil
+}
+
+func (s *Server) NotificationGetUnreadCountRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleNotificationGetUnreadCount(e echo.Context, input *handlers.NotificationGetUnreadCountInput) (*vylet.NotificationGetUnreadCount_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleNotificationGetUnreadCount", "viewer", viewer)
+
+	resp, err := s.client.Notification.GetUnreadNotificationCount(ctx, &vyletdatabase.GetUnreadNotificationCountRequest{
+		Did: viewer,
+	})
+	if err != nil {
+		logger.Error("failed to get unread notification count", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	return &vylet.NotificationGetUnreadCount_Output{
+		Count: resp.Count,
+	}, nil
+}
+
+func (s *Server) NotificationUpdateSeenRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleNotificationUpdateSeen(e echo.Context, input *vylet.NotificationUpdateSeen_Input) *echo.HTTPError {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleNotificationUpdateSeen", "viewer", viewer)
+
+	seenAt, err := syntax.ParseDatetime(input.SeenAt)
+	if err != nil {
+		return NewValidationError("seenAt", "seenAt must be a valid datetime")
+	}
+
+	_, err = s.client.Notification.UpdateNotificationsSeen(ctx, &vyletdatabase.UpdateNotificationsSeenRequest{
+		Did:    viewer,
+		SeenAt: timestamppb.New(seenAt.Time()),
+	})
+	if err != nil {
+		logger.Error("failed to update notifications seen", "err", err)
+		return ErrInternalServerErr
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bluesky-social/indigo/atproto/atclient"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/handlers"
+)
+
+var (
+	ErrPdsSessionMissing = errors.New("no pds session present in request context")
+)
+
+// Procedures that are proxied to the viewer's PDS. These routes carry PDS credentials rather than service auth
+// tokens, so didAuthMiddleware hands them to pdsSessionMiddleware instead of verifying them itself.
+var pdsProcedures = map[string]struct{}{
+	"/xrpc/app.vylet.feed.createPost":    {},
+	"/xrpc/app.vylet.feed.createLike":    {},
+	"/xrpc/app.vylet.graph.createFollow": {},
+	"/xrpc/app.vylet.media.uploadBlob":   {},
+	"/xrpc/app.vylet.repo.deleteRecords": {},
+}
+
+// PdsSession holds the credentials a client supplied for their PDS. The credentials are never verified by
+// the API itself; they are forwarded as-is and the PDS is the authority on whether they are valid.
+type PdsSession struct {
+	Did           string
+	Authorization string
+}
+
+// passthroughAuth implements atclient.AuthMethod by copying the client's own PDS credentials onto each request.
+type passthroughAuth struct {
+	session *PdsSession
+}
+
+func (a *passthroughAuth) DoWithAuth(c *http.Client, req *http.Request, endpoint syntax.NSID) (*http.Response, error) {
+	req.Header.Set("Authorization", a.session.Authorization)
+	return c.Do(req)
+}
+
+// Creates an API client for the session's PDS, as resolved from the DID document of the session's account.
+func (s *Server) pdsClientForSession(ctx context.Context, session *PdsSession) (*atclient.APIClient, error) {
+	pdsEndpoint, err := s.getPdsEndpoint(ctx, session.Did)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve pds endpoint: %w", err)
+	}
+
+	did, err := syntax.ParseDID(session.Did)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse session did: %w", err)
+	}
+
+	client := atclient.NewAPIClient(pdsEndpoint)
+	client.Client = s.pdsHttpClient
+	client.Headers.Set("User-Agent", "vylet-api")
+	client.Auth = &passthroughAuth{session: session}
+	client.AccountDID = &did
+
+	return client, nil
+}
+
+// Reads the subject DID out of a PDS access token without verifying it. Both legacy session tokens and OAuth
+// access tokens carry the account DID in the "sub" claim.
+func didFromAccessToken(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return "", fmt.Errorf("failed to parse access token: %w", err)
+	}
+
+	did, err := syntax.ParseDID(claims.Subject)
+	if err != nil {
+		return "", fmt.Errorf("invalid DID in 'sub' field of access token")
+	}
+
+	return did.String(), nil
+}
+
+// Middleware for procedures that are proxied to the viewer's PDS. Extracts the client's PDS credentials from
+// the Authorization header and stores them on the context, along with the token's DID as the viewer so that the
+// routes pass AuthRequiredMiddleware. The token isn't verified here, the PDS it's forwarded to does that, so the
+// viewer must not be trusted for anything but the proxied write. Only bearer tokens are accepted: a DPoP proof is
+// bound to the URL and method it was made for, which is the API's rather than the PDS's, so the PDS would never
+// accept a proof forwarded from here.
+func (s *Server) pdsSessionMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(e echo.Context) error {
+			authHeader := e.Request().Header.Get("Authorization")
+
+			if authHeader == "" {
+				return ErrUnauthorized
+			}
+
+			if strings.HasPrefix(authHeader, "DPoP ") {
+				return NewXRPCError(http.StatusUnauthorized, handlers.ErrorInvalidToken,
+					"DPoP-bound sessions can't be proxied to the PDS, use a bearer token")
+			}
+
+			token, ok := strings.CutPrefix(authHeader, "Bearer ")
+			if !ok {
+				return NewXRPCError(http.StatusUnauthorized, handlers.ErrorInvalidToken, "Invalid authorization format")
+			}
+
+			did, err := didFromAccessToken(token)
+			if err != nil {
+				return NewXRPCError(http.StatusUnauthorized, handlers.ErrorInvalidToken,
+					fmt.Sprintf("Token parsing failed: %v", err))
+			}
+
+			e.Set("pdsSession", &PdsSession{
+				Did:           did,
+				Authorization: authHeader,
+			})
+			e.Set("viewer", did)
+
+			return next(e)
+		}
+	}
+}
+
+func getPdsSession(e echo.Context) (*PdsSession, error) {
+	session, ok := e.Get("pdsSession").(*PdsSession)
+	if !ok || session == nil {
+		return nil, ErrPdsSessionMissing
+	}
+	return session, nil
+}
+
+// Maps an error returned by the PDS to an HTTP error that can be returned to the client. Client errors keep the
+// PDS's XRPC error name, so that lexicon errors such as InvalidSwap reach the client unchanged.
+func pdsErrorToHTTPError(err error) *echo.HTTPError {
+	var apiErr *atclient.APIError
+	if !errors.As(err, &apiErr) {
+		return ErrInternalServerErr
+	}
+
+	switch {
+	case apiErr.StatusCode >= 400 && apiErr.StatusCode < 500:
+		name := apiErr.Name
+		if name == "" {
+			name = handlers.ErrorNameForStatus(apiErr.StatusCode)
+		}
+		return NewXRPCError(apiErr.StatusCode, name, apiErr.Message)
+	default:
+		return NewXRPCError(http.StatusBadGateway, handlers.ErrorUpstreamFailure, "upstream pds error")
+	}
+}
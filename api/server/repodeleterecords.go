@@ -0,0 +1,84 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/generated/handlers"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+// Collections that may be deleted through the API. Anything else must be deleted on the PDS directly.
+var deletableCollections = map[string]struct{}{
+	"app.vylet.feed.post":    {},
+	"app.vylet.feed.like":    {},
+	"app.vylet.feed.comment": {},
+	"app.vylet.graph.follow": {},
+}
+
+func (s *Server) RepoDeleteRecordsRequiresAuth() bool {
+	return true
+}
+
+func (s *Server) HandleRepoDeleteRecords(e echo.Context, input *vylet.RepoDeleteRecords_Input) *echo.HTTPError {
+	ctx := e.Request().Context()
+	logger := s.logger.With("name", "HandleRepoDeleteRecords")
+
+	session, err := getPdsSession(e)
+	if err != nil {
+		return ErrUnauthorized
+	}
+
+	logger = logger.With("did", session.Did)
+
+	if len(input.Uris) == 0 {
+		return NewValidationError("uris", "must supply at least one AT-URI")
+	}
+
+	if len(input.Uris) > 25 {
+		return NewValidationError("uris", "no more than 25 AT-URIs may be supplied")
+	}
+
+	writes := make([]*comatproto.RepoApplyWrites_Input_Writes_Elem, 0, len(input.Uris))
+	for _, uri := range input.Uris {
+		aturi, err := syntax.ParseATURI(uri)
+		if err != nil {
+			return NewValidationError("uris", "all URIs must be valid AT-URIs")
+		}
+
+		if aturi.Authority().String() != session.Did {
+			return NewXRPCError(http.StatusBadRequest, handlers.RepoDeleteRecordsErrorNotRecordOwner, fmt.Sprintf("%s is not owned by the authenticated account", uri))
+		}
+
+		collection := aturi.Collection().String()
+		if _, ok := deletableCollections[collection]; !ok {
+			return NewXRPCError(http.StatusBadRequest, handlers.RepoDeleteRecordsErrorUnsupportedCollection, fmt.Sprintf("records in %s may not be deleted", collection))
+		}
+
+		writes = append(writes, &comatproto.RepoApplyWrites_Input_Writes_Elem{
+			RepoApplyWrites_Delete: &comatproto.RepoApplyWrites_Delete{
+				Collection: collection,
+				Rkey:       aturi.RecordKey().String(),
+			},
+		})
+	}
+
+	pdsClient, err := s.pdsClientForSession(ctx, session)
+	if err != nil {
+		logger.Error("failed to create pds client", "err", err)
+		return ErrInternalServerErr
+	}
+
+	if _, err := comatproto.RepoApplyWrites(ctx, pdsClient, &comatproto.RepoApplyWrites_Input{
+		Repo:   session.Did,
+		Writes: writes,
+	}); err != nil {
+		logger.Warn("failed to apply deletes on pds", "uris", input.Uris, "err", err)
+		return pdsErrorToHTTPError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/vylet-app/go/database/client"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/handlers"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+func (s *Server) FeedSearchPostsRequiresAuth() bool {
+	return false
+}
+
+func (s *Server) HandleFeedSearchPosts(e echo.Context, input *handlers.FeedSearchPostsInput) (*vylet.FeedSearchPosts_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleFeedSearchPosts", "viewer", viewer, "q", input.Q, "limit", *input.Limit, "cursor", input.Cursor)
+
+	req := vyletdatabase.SearchPostsRequest{
+		Query:  input.Q,
+		Limit:  *input.Limit,
+		Cursor: input.Cursor,
+	}
+	if viewer != "" {
+		req.ViewerDid = &viewer
+	}
+
+	resp, err := s.client.Search.SearchPosts(ctx, &req)
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
+	if err != nil {
+		logger.Error("failed to search posts", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	if len(resp.Uris) == 0 {
+		return &vylet.FeedSearchPosts_Output{
+			Posts:  []*vylet.FeedDefs_PostView{},
+			Cursor: resp.Cursor,
+		}, nil
+	}
+
+	postViews, err := s.getPostViews(ctx, resp.Uris, viewer)
+	if err != nil {
+		logger.Error("failed to get post views", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	orderedPostViews := make([]*vylet.FeedDefs_PostView, 0, len(postViews))
+	for _, uri := range resp.Uris {
+		postView, ok := postViews[uri]
+		if !ok {
+			continue
+		}
+		orderedPostViews = append(orderedPostViews, postView)
+	}
+
+	return &vylet.FeedSearchPosts_Output{
+		Posts:  orderedPostViews,
+		Cursor: resp.Cursor,
+	}, nil
+}
+
+func (s *Server) ActorSearchActorsRequiresAuth() bool {
+	return false
+}
+
+func (s *Server) HandleActorSearchActors(e echo.Context, input *handlers.ActorSearchActorsInput) (*vylet.ActorSearchActors_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+	viewer := getViewer(e)
+
+	logger := s.logger.With("name", "HandleActorSearchActors", "viewer", viewer, "q", input.Q, "limit", *input.Limit, "cursor", input.Cursor)
+
+	resp, err := s.client.Search.SearchActors(ctx, &vyletdatabase.SearchActorsRequest{
+		Query:  input.Q,
+		Limit:  *input.Limit,
+		Cursor: input.Cursor,
+	})
+	if client.IsInvalidCursorError(err) {
+		return nil, ErrInvalidCursor
+	}
+	if err != nil {
+		logger.Error("failed to search actors", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	if len(resp.Dids) == 0 {
+		return &vylet.ActorSearchActors_Output{
+			Actors: []*vylet.ActorDefs_ProfileView{},
+			Cursor: resp.Cursor,
+		}, nil
+	}
+
+	profiles, err := s.getProfiles(ctx, resp.Dids)
+	if err != nil {
+		logger.Error("failed to get profiles", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	actors := make([]*vylet.ActorDefs_ProfileView, 0, len(profiles))
+	for _, did := range resp.Dids {
+		profile, ok := profiles[did]
+		if !ok {
+			continue
+		}
+		actors = append(actors, profile)
+	}
+
+	return &vylet.ActorSearchActors_Output{
+		Actors: actors,
+		Cursor: resp.Cursor,
+	}, nil
+}
+
+func (s *Server) ActorSearchActorsTypeaheadRequiresAuth() bool {
+	return false
+}
+
+func (s *Server) HandleActorSearchActorsTypeahead(e echo.Context, input *handlers.ActorSearchActorsTypeaheadInput) (*vylet.ActorSearchActorsTypeahead_Output, *echo.HTTPError) {
+	ctx := e.Request().Context()
+
+	logger := s.logger.With("name", "HandleActorSearchActorsTypeahead", "q", input.Q, "limit", *input.Limit)
+
+	resp, err := s.client.Search.SearchActorsTypeahead(ctx, &vyletdatabase.SearchActorsTypeaheadRequest{
+		Query: input.Q,
+		Limit: *input.Limit,
+	})
+	if err != nil {
+		logger.Error("failed to search actors typeahead", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	if len(resp.Dids) == 0 {
+		return &vylet.ActorSearchActorsTypeahead_Output{
+			Actors: []*vylet.ActorDefs_ProfileViewBasic{},
+		}, nil
+	}
+
+	profiles, err := s.getProfilesBasic(ctx, resp.Dids)
+	if err != nil {
+		logger.Error("failed to get profiles", "err", err)
+		return nil, ErrInternalServerErr
+	}
+
+	actors := make([]*vylet.ActorDefs_ProfileViewBasic, 0, len(profiles))
+	for _, did := range resp.Dids {
+		profile, ok := profiles[did]
+		if !ok {
+			continue
+		}
+		actors = append(actors, profile)
+	}
+
+	return &vylet.ActorSearchActorsTypeahead_Output{
+		Actors: actors,
+	}, nil
+}
@@ -31,12 +31,24 @@ type Server struct {
 	echo      *echo.Echo
 	client    *client.Client
 	directory *identity.CacheDirectory
+
+	pdsHttpClient    *http.Client
+	optimisticWrites bool
+	publicActorLikes bool
 }
 
 type Args struct {
-	Logger *slog.Logger
-	Addr   string
-	DbHost string
+	Logger    *slog.Logger
+	Addr      string
+	DbHost    string
+	DbOptions client.Options
+
+	// If true, records written through the API are also written to the database immediately instead of
+	// waiting for the indexer to see them on the firehose.
+	OptimisticWrites bool
+
+	// If true, any viewer may list the posts an actor has liked. Otherwise only the actor themselves may.
+	PublicActorLikes bool
 }
 
 func New(args *Args) (*Server, error) {
@@ -60,7 +72,8 @@ func New(args *Args) (*Server, error) {
 	}
 
 	client, err := client.New(&client.Args{
-		Addr: args.DbHost,
+		Addr:    args.DbHost,
+		Options: args.DbOptions,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new database client: %w", err)
@@ -83,6 +96,12 @@ func New(args *Args) (*Server, error) {
 		httpd:     &httpd,
 		client:    client,
 		directory: &directory,
+
+		pdsHttpClient: &http.Client{
+			Timeout: time.Second * 10,
+		},
+		optimisticWrites: args.OptimisticWrites,
+		publicActorLikes: args.PublicActorLikes,
 	}
 
 	server.echo.HTTPErrorHandler = server.errorHandler
@@ -156,23 +175,38 @@ func (s *Server) errorHandler(err error, c echo.Context) {
 	}
 
 	code := http.StatusInternalServerError
-	var message any = map[string]string{"error": "internal server error"}
+	body := xrpcErrorBody{
+		Error:   handlers.ErrorInternalServerError,
+		Message: "internal server error",
+	}
 
 	if he, ok := err.(*echo.HTTPError); ok {
 		code = he.Code
 
-		if validationErrs, ok := he.Message.(ValidationErrors); ok {
-			message = validationErrs
-		} else {
-			message = map[string]any{
-				"error": he.Message,
+		switch message := he.Message.(type) {
+		case XRPCError:
+			body = xrpcErrorBody{
+				Error:   message.Name,
+				Message: message.Message,
+			}
+		case ValidationErrors:
+			body = xrpcErrorBody{
+				Error:   handlers.ErrorInvalidRequest,
+				Message: message.Message(),
+				Errors:  message.Errors,
+			}
+		default:
+			// Errors created by echo itself (unknown routes, body limits, etc.) are only given a status code
+			body = xrpcErrorBody{
+				Error:   handlers.ErrorNameForStatus(code),
+				Message: fmt.Sprint(message),
 			}
 		}
 	} else {
 		c.Logger().Error(err)
 	}
 
-	if err := c.JSON(code, message); err != nil {
+	if err := c.JSON(code, body); err != nil {
 		c.Logger().Error(err)
 	}
 }
@@ -240,8 +274,17 @@ func (s *Server) checkJwt(ctx context.Context, token string) (string, error) {
 }
 
 func (s *Server) didAuthMiddleware() echo.MiddlewareFunc {
+	pdsSession := s.pdsSessionMiddleware()
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withPdsSession := pdsSession(next)
+
 		return func(e echo.Context) error {
+			// PDS proxied procedures carry the viewer's PDS credentials, which are handled by pdsSessionMiddleware
+			if _, ok := pdsProcedures[e.Path()]; ok {
+				return withPdsSession(e)
+			}
+
 			authHeader := e.Request().Header.Get("Authorization")
 
 			if authHeader == "" {
@@ -249,7 +292,7 @@ func (s *Server) didAuthMiddleware() echo.MiddlewareFunc {
 			}
 
 			if !strings.HasPrefix(authHeader, "Bearer ") {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid authorization format")
+				return NewXRPCError(http.StatusUnauthorized, handlers.ErrorInvalidToken, "Invalid authorization format")
 			}
 
 			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
@@ -257,7 +300,7 @@ func (s *Server) didAuthMiddleware() echo.MiddlewareFunc {
 			ctx := e.Request().Context()
 			userDid, err := s.checkJwt(ctx, tokenString)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized,
+				return NewXRPCError(http.StatusUnauthorized, handlers.ErrorInvalidToken,
 					fmt.Sprintf("Token verification failed: %v", err))
 			}
 
@@ -2,11 +2,14 @@ package cdn
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/bluesky-social/indigo/atproto/atdata"
 	vyletkafka "github.com/vylet-app/go/bus/proto"
+	"github.com/vylet-app/go/database/client"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -37,7 +40,10 @@ func (s *Server) handleCommit(ctx context.Context, evt *vyletkafka.FirehoseEvent
 		blobs := atdata.ExtractBlobs(rec)
 
 		if len(blobs) == 0 {
-			// No blobs in this record, nothing to do
+			// An updated record may have stopped using the blobs it used before
+			if op.Operation == vyletkafka.CommitOperation_COMMIT_OPERATION_UPDATE {
+				return s.setRecordBlobUsages(ctx, evt, nil)
+			}
 			return nil
 		}
 
@@ -51,18 +57,18 @@ func (s *Server) handleCommit(ctx context.Context, evt *vyletkafka.FirehoseEvent
 			cid := blob.Ref.String()
 
 			// Check if blob ref already exists
-			getResp, err := s.db.BlobRef.GetBlobRef(ctx, &vyletdatabase.GetBlobRefRequest{
+			_, err := s.db.BlobRef.GetBlobRef(ctx, &vyletdatabase.GetBlobRefRequest{
 				Did: evt.Did,
 				Cid: cid,
 			})
-			if err != nil {
+			if err != nil && !client.IsNotFoundError(err) {
 				logger.Error("failed to check if blob ref exists", "cid", cid, "err", err)
 				continue
 			}
 
 			// If blob ref doesn't exist, create it
-			if getResp.Error != nil {
-				createResp, err := s.db.BlobRef.CreateBlobRef(ctx, &vyletdatabase.CreateBlobRefRequest{
+			if client.IsNotFoundError(err) {
+				_, err := s.db.BlobRef.CreateBlobRef(ctx, &vyletdatabase.CreateBlobRefRequest{
 					BlobRef: &vyletdatabase.BlobRef{
 						Did:         evt.Did,
 						Cid:         cid,
@@ -75,44 +81,67 @@ func (s *Server) handleCommit(ctx context.Context, evt *vyletkafka.FirehoseEvent
 					logger.Error("failed to create blob ref", "cid", cid, "err", err)
 					continue
 				}
-				if createResp.Error != nil {
-					dbOperations.WithLabelValues("create", "error").Inc()
-					logger.Error("error creating blob ref", "cid", cid, "error", *createResp.Error)
-					continue
-				}
 
 				dbOperations.WithLabelValues("create", "success").Inc()
 				logger.Debug("created blob ref", "cid", cid)
 			} else {
-				// Blob ref already exists, update the updated_at timestamp
-				updateResp, err := s.db.BlobRef.UpdateBlobRef(ctx, &vyletdatabase.UpdateBlobRefRequest{
+				// Blob ref already exists, update the updated_at timestamp. The empty mask leaves every other field
+				// alone.
+				_, err := s.db.BlobRef.UpdateBlobRef(ctx, &vyletdatabase.UpdateBlobRefRequest{
 					BlobRef: &vyletdatabase.BlobRef{
-						Did:       evt.Did,
-						Cid:       cid,
-						TakenDown: getResp.BlobRef.TakenDown,
+						Did: evt.Did,
+						Cid: cid,
 					},
+					UpdateMask: &fieldmaskpb.FieldMask{},
 				})
 				if err != nil {
 					dbOperations.WithLabelValues("update", "error").Inc()
 					logger.Error("failed to update blob ref", "cid", cid, "err", err)
 					continue
 				}
-				if updateResp.Error != nil {
-					dbOperations.WithLabelValues("update", "error").Inc()
-					logger.Error("error updating blob ref", "cid", cid, "error", *updateResp.Error)
-					continue
-				}
 
 				dbOperations.WithLabelValues("update", "success").Inc()
 				logger.Debug("updated blob ref", "cid", cid)
 			}
 		}
 
+		cids := make([]string, 0, len(blobs))
+		for _, blob := range blobs {
+			cids = append(cids, blob.Ref.String())
+		}
+		if err := s.setRecordBlobUsages(ctx, evt, cids); err != nil {
+			return err
+		}
+
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE:
-		// For deletes, we don't remove blob refs since they might be referenced elsewhere
-		// We could potentially track reference counts in the future
-		return nil
+		// Blob refs aren't removed since other records might use the same blob. Instead the record's usages are
+		// removed, and blobs that are no longer used by any record are eventually orphaned by garbage collection.
+		if err := s.setRecordBlobUsages(ctx, evt, nil); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// Records the blobs used by the record in an event, replacing the ones it used before. Errors are returned rather
+// than skipped, since the event is the only record of which blobs the record uses.
+func (s *Server) setRecordBlobUsages(ctx context.Context, evt *vyletkafka.FirehoseEvent, cids []string) error {
+	recordUri := fmt.Sprintf("at://%s/%s/%s", evt.Did, evt.Commit.Collection, evt.Commit.Rkey)
+	logger := s.logger.With("name", "setRecordBlobUsages", "uri", recordUri)
+
+	_, err := s.db.BlobRef.SetRecordBlobUsages(ctx, &vyletdatabase.SetRecordBlobUsagesRequest{
+		Did:       evt.Did,
+		RecordUri: recordUri,
+		Cids:      cids,
+	})
+	if err != nil {
+		dbOperations.WithLabelValues("set_usages", "error").Inc()
+		logger.Error("failed to set record blob usages", "err", err)
+		return fmt.Errorf("failed to set record blob usages: %w", err)
+	}
+
+	dbOperations.WithLabelValues("set_usages", "success").Inc()
+
+	return nil
+}
@@ -28,7 +28,8 @@ type Args struct {
 	InputTopic       string
 	ConsumerGroup    string
 
-	DatabaseHost string
+	DatabaseHost    string
+	DatabaseOptions client.Options
 }
 
 func New(args *Args) (*Server, error) {
@@ -39,7 +40,8 @@ func New(args *Args) (*Server, error) {
 	logger := args.Logger
 
 	db, err := client.New(&client.Args{
-		Addr: args.DatabaseHost,
+		Addr:    args.DatabaseHost,
+		Options: args.DatabaseOptions,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a new database client: %w", err)
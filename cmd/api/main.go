@@ -9,12 +9,13 @@ import (
 	"github.com/bluesky-social/go-util/pkg/telemetry"
 	"github.com/urfave/cli/v2"
 	"github.com/vylet-app/go/api/server"
+	"github.com/vylet-app/go/database/client"
 )
 
 func main() {
 	app := cli.App{
 		Name: "api",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			telemetry.CLIFlagDebug,
 			telemetry.CLIFlagMetricsListenAddress,
 			&cli.StringFlag{
@@ -27,7 +28,17 @@ func main() {
 				Value:   "localhost:9090",
 				EnvVars: []string{"VYLET_API_DB_HOST"},
 			},
-		},
+			&cli.BoolFlag{
+				Name:    "optimistic-writes",
+				Usage:   "write records created through the api to the database before the indexer sees them",
+				EnvVars: []string{"VYLET_API_OPTIMISTIC_WRITES"},
+			},
+			&cli.BoolFlag{
+				Name:    "public-actor-likes",
+				Usage:   "allow any viewer to list the posts an actor has liked instead of only the actor themselves",
+				EnvVars: []string{"VYLET_API_PUBLIC_ACTOR_LIKES"},
+			},
+		}, client.Flags("VYLET_API")...),
 		Action: run,
 	}
 
@@ -42,10 +53,19 @@ func run(cmd *cli.Context) error {
 	logger := telemetry.StartLogger(cmd)
 	telemetry.StartMetrics(cmd)
 
+	dbOptions, err := client.OptionsFromCLI(cmd)
+	if err != nil {
+		return err
+	}
+
 	server, err := server.New(&server.Args{
-		Logger: logger,
-		Addr:   cmd.String("listen-addr"),
-		DbHost: cmd.String("db-host"),
+		Logger:    logger,
+		Addr:      cmd.String("listen-addr"),
+		DbHost:    cmd.String("db-host"),
+		DbOptions: dbOptions,
+
+		OptimisticWrites: cmd.Bool("optimistic-writes"),
+		PublicActorLikes: cmd.Bool("public-actor-likes"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create new server: %w", err)
@@ -10,12 +10,13 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/urfave/cli/v2"
 	"github.com/vylet-app/go/cdn"
+	"github.com/vylet-app/go/database/client"
 )
 
 func main() {
 	app := cli.App{
 		Name: "vylet-cdn",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			telemetry.CLIFlagDebug,
 			telemetry.CLIFlagMetricsListenAddress,
 			&cli.StringFlag{
@@ -38,7 +39,7 @@ func main() {
 				Required: true,
 				EnvVars:  []string{"VYLET_CDN_CONSUMER_GROUP"},
 			},
-		},
+		}, client.Flags("VYLET_CDN")...),
 		Action: run,
 	}
 
@@ -53,12 +54,18 @@ func run(cmd *cli.Context) error {
 	logger := telemetry.StartLogger(cmd)
 	telemetry.StartMetrics(cmd)
 
+	dbOptions, err := client.OptionsFromCLI(cmd)
+	if err != nil {
+		return err
+	}
+
 	server, err := cdn.New(&cdn.Args{
 		Logger:           logger,
 		BootstrapServers: cmd.StringSlice("bootstrap-servers"),
 		InputTopic:       cmd.String("input-topic"),
 		ConsumerGroup:    cmd.String("consumer-group"),
 		DatabaseHost:     cmd.String("database-host"),
+		DatabaseOptions:  dbOptions,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create new server: %w", err)
@@ -5,16 +5,18 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/bluesky-social/go-util/pkg/telemetry"
 	"github.com/urfave/cli/v2"
+	"github.com/vylet-app/go/database/cassandra"
 	"github.com/vylet-app/go/database/server"
 )
 
 func main() {
 	app := cli.App{
 		Name: "vylet-database",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			telemetry.CLIFlagDebug,
 			telemetry.CLIFlagMetricsListenAddress,
 			&cli.StringFlag{
@@ -22,17 +24,71 @@ func main() {
 				Value:   ":9090",
 				EnvVars: []string{"VYLET_DATABASE_LISTEN_ADDR"},
 			},
-			&cli.StringSliceFlag{
-				Name:    "cassandra-addrs",
-				Value:   cli.NewStringSlice("127.0.0.1"),
-				EnvVars: []string{"VYLET_DATABASE_CASSANDRA_ADDRS"},
+			&cli.StringFlag{
+				Name:    "cursor-secret",
+				Usage:   "secret used to sign pagination cursors, shared by every replica",
+				EnvVars: []string{"VYLET_DATABASE_CURSOR_SECRET"},
+			},
+			&cli.DurationFlag{
+				Name:    "blob-gc-grace-period",
+				Usage:   "how long a blob must go unreferenced before it is orphaned and no longer served",
+				Value:   24 * time.Hour,
+				EnvVars: []string{"VYLET_DATABASE_BLOB_GC_GRACE_PERIOD"},
+			},
+			&cli.StringFlag{
+				Name:    "like-count-strategy",
+				Usage:   "how posts' like counts are kept, counter or aggregate",
+				Value:   string(server.LikeCountStrategyCounter),
+				EnvVars: []string{"VYLET_DATABASE_LIKE_COUNT_STRATEGY"},
+			},
+			&cli.BoolFlag{
+				Name:    "insecure",
+				Usage:   "serve without TLS, for local development only",
+				EnvVars: []string{"VYLET_DATABASE_INSECURE"},
+			},
+			&cli.StringFlag{
+				Name:    "tls-cert-file",
+				Usage:   "PEM certificate presented to clients. required unless --insecure is set",
+				EnvVars: []string{"VYLET_DATABASE_TLS_CERT_FILE"},
+			},
+			&cli.StringFlag{
+				Name:    "tls-key-file",
+				Usage:   "PEM private key for the TLS certificate",
+				EnvVars: []string{"VYLET_DATABASE_TLS_KEY_FILE"},
 			},
 			&cli.StringFlag{
-				Name:    "cassandra-keyspace",
-				Value:   "vylet",
-				EnvVars: []string{"VYLET_DATABASE_CASSANDRA_KEYSPACE"},
+				Name:    "tls-client-ca-file",
+				Usage:   "PEM CA bundle that client certificates must be signed by. enables mutual TLS",
+				EnvVars: []string{"VYLET_DATABASE_TLS_CLIENT_CA_FILE"},
 			},
-		},
+			&cli.StringFlag{
+				Name:    "authz-policy-file",
+				Usage:   "JSON file mapping client certificate common names to the RPCs they may call",
+				EnvVars: []string{"VYLET_DATABASE_AUTHZ_POLICY_FILE"},
+			},
+			&cli.StringFlag{
+				Name:    "health-listen-addr",
+				Usage:   "address for the /livez and /readyz http endpoints",
+				Value:   ":9095",
+				EnvVars: []string{"VYLET_DATABASE_HEALTH_LISTEN_ADDR"},
+			},
+			&cli.DurationFlag{
+				Name:    "shutdown-timeout",
+				Usage:   "how long in flight requests are given to finish on shutdown",
+				Value:   30 * time.Second,
+				EnvVars: []string{"VYLET_DATABASE_SHUTDOWN_TIMEOUT"},
+			},
+			&cli.BoolFlag{
+				Name:    "auto-migrate",
+				Usage:   "apply pending migrations on startup. only one replica migrates at a time",
+				EnvVars: []string{"VYLET_DATABASE_AUTO_MIGRATE"},
+			},
+			&cli.StringFlag{
+				Name:    "migrations-environment",
+				Usage:   "environment whose variables are rendered into migrations",
+				EnvVars: []string{"VYLET_DATABASE_MIGRATIONS_ENVIRONMENT"},
+			},
+		}, cassandra.Flags()...),
 		Action: run,
 	}
 
@@ -47,12 +103,31 @@ func run(cmd *cli.Context) error {
 	logger := telemetry.StartLogger(cmd)
 	telemetry.StartMetrics(cmd)
 
+	cassandraConfig, err := cassandra.ConfigFromCLI(cmd)
+	if err != nil {
+		return err
+	}
+
 	server, err := server.New(&server.Args{
 		Logger: logger,
 
 		ListenAddr:        cmd.String("listen-addr"),
-		CassandraAddrs:    cmd.StringSlice("cassandra-addrs"),
-		CassandraKeyspace: cmd.String("cassandra-keyspace"),
+		Cassandra:         cassandraConfig,
+		CursorSecret:      cmd.String("cursor-secret"),
+		BlobGCGracePeriod: cmd.Duration("blob-gc-grace-period"),
+		LikeCountStrategy: server.LikeCountStrategy(cmd.String("like-count-strategy")),
+
+		Insecure:        cmd.Bool("insecure"),
+		TLSCertFile:     cmd.String("tls-cert-file"),
+		TLSKeyFile:      cmd.String("tls-key-file"),
+		TLSClientCAFile: cmd.String("tls-client-ca-file"),
+		AuthzPolicyFile: cmd.String("authz-policy-file"),
+
+		HealthListenAddr: cmd.String("health-listen-addr"),
+		ShutdownTimeout:  cmd.Duration("shutdown-timeout"),
+
+		AutoMigrate:           cmd.Bool("auto-migrate"),
+		MigrationsEnvironment: cmd.String("migrations-environment"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create new server: %w", err)
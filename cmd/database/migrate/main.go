@@ -1,42 +1,64 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"io/fs"
 	"log"
+	"log/slog"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 	"time"
 
+	"github.com/bluesky-social/go-util/pkg/telemetry"
 	"github.com/gocql/gocql"
 	"github.com/urfave/cli/v2"
-	"github.com/vylet-app/go/database/server"
+	"github.com/vylet-app/go/database/cassandra"
+	"github.com/vylet-app/go/database/migrate"
+	vyletmigrations "github.com/vylet-app/go/migrations"
 )
 
 func main() {
 	app := &cli.App{
 		Name:  "migrate",
 		Usage: "Cassandra database migration tool",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
+			telemetry.CLIFlagDebug,
 			&cli.StringFlag{
 				Name:    "migrations",
 				Aliases: []string{"m"},
-				Value:   "./migrations",
-				Usage:   "Path to migrations directory",
+				Usage:   "Path to migrations directory. defaults to the migrations built into the binary",
 				EnvVars: []string{"VYLET_DATABASE_MIGRATIONS_PATH"},
 			},
-			&cli.StringSliceFlag{
-				Name:    "cassandra-addrs",
-				Value:   cli.NewStringSlice("127.0.0.1"),
-				Usage:   "Comma-separated Cassandra hosts",
-				EnvVars: []string{"VYLET_DATABASE_CASSANDRA_ADDRS", "VYLET_DATABASE_CASSANDRA_HOSTS"},
+			&cli.BoolFlag{
+				Name:    "create-keyspace",
+				Usage:   "Create the keyspace if it does not exist",
+				EnvVars: []string{"VYLET_DATABASE_CREATE_KEYSPACE"},
 			},
 			&cli.StringFlag{
-				Name:    "cassandra-keyspace",
-				Aliases: []string{"k"},
-				Value:   "vylet",
-				Usage:   "Cassandra keyspace",
-				EnvVars: []string{"VYLET_DATABASE_CASSANDRA_KEYSPACE"},
+				Name:    "keyspace-replication",
+				Usage:   "Replication of a created keyspace, as a CQL map. defaults to the environment's replication variable",
+				EnvVars: []string{"VYLET_DATABASE_KEYSPACE_REPLICATION"},
 			},
-		},
+			&cli.StringFlag{
+				Name:    "environment",
+				Aliases: []string{"e"},
+				Usage:   "Environment whose variables from the migrations' environments directory are rendered into migrations",
+				EnvVars: []string{"VYLET_DATABASE_MIGRATIONS_ENVIRONMENT"},
+			},
+			&cli.GenericFlag{
+				Name:  "var",
+				Usage: "Variable rendered into migrations as name=value, overriding the environment's. may be repeated",
+				Value: varsFlag{},
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Print the rendered CQL that would run instead of running it",
+			},
+		}, cassandra.Flags()...),
 		Commands: []*cli.Command{
 			{
 				Name:    "up",
@@ -50,6 +72,23 @@ func main() {
 				Usage:   "Rollback last migration",
 				Action:  runMigrationsDown,
 			},
+			{
+				Name:   "status",
+				Usage:  "Show applied and pending migrations",
+				Action: runMigrationsStatus,
+			},
+			{
+				Name:      "goto",
+				Usage:     "Migrate up or down to a version, 0 rolls back every migration",
+				ArgsUsage: "<version>",
+				Action:    runMigrationsGoto,
+			},
+			{
+				Name:      "force",
+				Usage:     "Record a version as applied without running migrations, to recover from a dirty schema",
+				ArgsUsage: "<version>",
+				Action:    runMigrationsForce,
+			},
 		},
 		Action: func(c *cli.Context) error {
 			// Default action if no command specified
@@ -63,50 +102,197 @@ func main() {
 }
 
 func runMigrationsUp(c *cli.Context) error {
-	session, err := connectCassandra(c)
+	return withMigrator(c, func(ctx context.Context, m *migrate.Migrator) error {
+		if err := m.Up(ctx); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		return nil
+	})
+}
+
+func runMigrationsDown(c *cli.Context) error {
+	return withMigrator(c, func(ctx context.Context, m *migrate.Migrator) error {
+		if err := m.Down(ctx); err != nil {
+			return fmt.Errorf("rollback failed: %w", err)
+		}
+		return nil
+	})
+}
+
+func runMigrationsGoto(c *cli.Context) error {
+	version, err := versionArg(c)
 	if err != nil {
 		return err
 	}
-	defer session.Close()
 
-	migrationsPath := c.String("migrations")
-	log.Println("Running migrations...")
-	if err := server.RunMigrations(session, migrationsPath); err != nil {
-		log.Fatalf("Migration failed: %v", err)
-	}
-	log.Println("Migrations completed successfully")
-	return nil
+	return withMigrator(c, func(ctx context.Context, m *migrate.Migrator) error {
+		if err := m.Goto(ctx, version); err != nil {
+			return fmt.Errorf("migration failed: %w", err)
+		}
+		return nil
+	})
 }
 
-func runMigrationsDown(c *cli.Context) error {
-	session, err := connectCassandra(c)
+func runMigrationsForce(c *cli.Context) error {
+	version, err := versionArg(c)
 	if err != nil {
 		return err
 	}
-	defer session.Close()
 
-	migrationsPath := c.String("migrations")
-	log.Println("Rolling back last migration...")
-	if err := server.RollbackMigration(session, migrationsPath); err != nil {
-		log.Fatalf("Rollback failed: %v", err)
+	return withMigrator(c, func(ctx context.Context, m *migrate.Migrator) error {
+		return m.Force(ctx, version)
+	})
+}
+
+func runMigrationsStatus(c *cli.Context) error {
+	return withMigrator(c, func(ctx context.Context, m *migrate.Migrator) error {
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, st := range statuses {
+			state := "pending"
+			switch {
+			case st.Dirty:
+				state = "dirty"
+			case st.Missing:
+				state = "missing"
+			case st.Modified:
+				state = "modified"
+			case st.Applied:
+				state = "applied"
+			}
+
+			appliedAt := "-"
+			if st.Applied {
+				appliedAt = st.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, state, appliedAt)
+		}
+		return w.Flush()
+	})
+}
+
+func versionArg(c *cli.Context) (int64, error) {
+	if c.NArg() != 1 {
+		return 0, fmt.Errorf("expected a single version argument")
 	}
-	log.Println("Rollback completed successfully")
+	version, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid version %q", c.Args().First())
+	}
+	return version, nil
+}
+
+// Collects repeated name=value flags. Values may contain commas, such as CQL maps.
+type varsFlag map[string]string
+
+func (v varsFlag) Set(value string) error {
+	name, val, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("expected name=value, got %q", value)
+	}
+	v[name] = val
 	return nil
 }
 
-func connectCassandra(c *cli.Context) (*gocql.Session, error) {
-	fmt.Println(c.StringSlice("cassandra-addrs"))
-	cluster := gocql.NewCluster(c.StringSlice("cassandra-addrs")...)
-	cluster.Keyspace = c.String("cassandra-keyspace")
-	cluster.Consistency = gocql.Quorum
-	cluster.ProtoVersion = 4
-	cluster.ConnectTimeout = time.Second * 10
-	cluster.Timeout = time.Second * 10
+func (v varsFlag) String() string {
+	pairs := make([]string, 0, len(v))
+	for name, val := range v {
+		pairs = append(pairs, name+"="+val)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
+
+// Connects to the keyspace, creating it first if asked to, and runs fn with a migrator for it. A dry run connects
+// without a keyspace, so that it works before the keyspace exists, and prints the keyspace creation instead.
+func withMigrator(c *cli.Context, fn func(ctx context.Context, m *migrate.Migrator) error) error {
+	ctx := c.Context
+
+	level := slog.LevelInfo
+	if c.Bool("debug") {
+		level = slog.LevelDebug
+	}
+	// Logs go to stderr so that they don't mix with command output
+	logger := telemetry.StartLogger(c, telemetry.WithHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: level,
+	})))
+
+	cassandraConfig, err := cassandra.ConfigFromCLI(c)
+	if err != nil {
+		return err
+	}
+
+	keyspace := cassandraConfig.Keyspace
+	var migrations fs.FS = vyletmigrations.FS
+	if path := c.String("migrations"); path != "" {
+		migrations = os.DirFS(path)
+	}
+	environment := c.String("environment")
+	vars := c.Generic("var").(varsFlag)
+	dryRun := c.Bool("dry-run")
+
+	if c.Bool("create-keyspace") {
+		replication := c.String("keyspace-replication")
+		if replication == "" {
+			envVars, err := migrate.LoadVars(migrations, environment)
+			if err != nil {
+				return err
+			}
+			replication = envVars[migrate.ReplicationVar]
+			if v, ok := vars[migrate.ReplicationVar]; ok {
+				replication = v
+			}
+		}
+
+		if dryRun {
+			fmt.Printf("-- create keyspace\n%s;\n\n", migrate.CreateKeyspaceStatement(keyspace, replication))
+		} else {
+			session, err := connectCassandra(cassandraConfig, logger, "")
+			if err != nil {
+				return err
+			}
+			err = migrate.CreateKeyspace(ctx, session, keyspace, replication)
+			session.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	sessionKeyspace := keyspace
+	if dryRun {
+		sessionKeyspace = ""
+	}
+	session, err := connectCassandra(cassandraConfig, logger, sessionKeyspace)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
 
-	session, err := cluster.CreateSession()
+	m, err := migrate.New(&migrate.Args{
+		Logger:      logger,
+		Session:     session,
+		Keyspace:    keyspace,
+		Migrations:  migrations,
+		Environment: environment,
+		Vars:        vars,
+		DryRun:      dryRun,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to cassandra: %w", err)
+		return err
 	}
 
-	return session, nil
+	return fn(ctx, m)
+}
+
+func connectCassandra(config cassandra.Config, logger *slog.Logger, keyspace string) (*gocql.Session, error) {
+	config.Keyspace = keyspace
+	logger.Debug("connecting to cassandra", "addrs", config.Addrs, "keyspace", keyspace)
+	return config.NewSession()
 }
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+)
+
+// An error declared in a query or procedure's lexicon.
+type lexiconError struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Generates constants for the error names declared by a lexicon, so that handlers don't need to repeat them as
+// string literals.
+func generateErrorConstants(name string, errs []lexiconError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+
+	contents := "\n// Errors declared by the lexicon\nconst (\n"
+	for _, lexErr := range errs {
+		if lexErr.Description != "" {
+			contents += fmt.Sprintf("\t// %s\n", lexErr.Description)
+		}
+		contents += fmt.Sprintf("\t%sError%s = %q\n", name, lexErr.Name, lexErr.Name)
+	}
+	contents += ")\n"
+
+	return contents
+}
+
+func generateErrorsFile(packageName string) string {
+	return fmt.Sprintf(`// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package %s
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Generic XRPC error names. Lexicons may declare additional, method specific, names.
+const (
+	ErrorInvalidRequest       = "InvalidRequest"
+	ErrorAuthRequired         = "AuthRequired"
+	ErrorInvalidToken         = "InvalidToken"
+	ErrorExpiredToken         = "ExpiredToken"
+	ErrorForbidden            = "Forbidden"
+	ErrorNotFound             = "NotFound"
+	ErrorMethodNotImplemented = "MethodNotImplemented"
+	ErrorPayloadTooLarge      = "PayloadTooLarge"
+	ErrorRateLimitExceeded    = "RateLimitExceeded"
+	ErrorInternalServerError  = "InternalServerError"
+	ErrorUpstreamFailure      = "UpstreamFailure"
+	ErrorNotEnoughResources   = "NotEnoughResources"
+	ErrorUpstreamTimeout      = "UpstreamTimeout"
+)
+
+// XRPCError is the body of an XRPC error response.
+type XRPCError struct {
+	Name    string `+"`json:\"error\"`"+`
+	Message string `+"`json:\"message,omitempty\"`"+`
+}
+
+func (x XRPCError) Error() string {
+	if x.Message == "" {
+		return x.Name
+	}
+	return x.Name + ": " + x.Message
+}
+
+func NewXRPCError(code int, name, message string) *echo.HTTPError {
+	return echo.NewHTTPError(code, XRPCError{
+		Name:    name,
+		Message: message,
+	})
+}
+
+// Returns the generic XRPC error name for an HTTP status code, for errors that weren't given a name explicitly.
+func ErrorNameForStatus(code int) string {
+	switch code {
+	case http.StatusBadRequest:
+		return ErrorInvalidRequest
+	case http.StatusUnauthorized:
+		return ErrorAuthRequired
+	case http.StatusForbidden:
+		return ErrorForbidden
+	case http.StatusNotFound:
+		return ErrorNotFound
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return ErrorMethodNotImplemented
+	case http.StatusRequestEntityTooLarge:
+		return ErrorPayloadTooLarge
+	case http.StatusTooManyRequests:
+		return ErrorRateLimitExceeded
+	case http.StatusBadGateway:
+		return ErrorUpstreamFailure
+	case http.StatusServiceUnavailable:
+		return ErrorNotEnoughResources
+	case http.StatusGatewayTimeout:
+		return ErrorUpstreamTimeout
+	}
+
+	if code >= 400 && code < 500 {
+		return ErrorInvalidRequest
+	}
+	return ErrorInternalServerError
+}
+
+type ValidationError struct {
+	Field   string `+"`json:\"field\"`"+`
+	Message string `+"`json:\"message\"`"+`
+}
+
+// ValidationErrors are returned as an InvalidRequest XRPC error, with the individual errors included alongside
+// the summary message.
+type ValidationErrors struct {
+	Errors []ValidationError `+"`json:\"errors\"`"+`
+}
+
+func (v ValidationErrors) Error() string {
+	return "validation failed"
+}
+
+// Returns all of the validation messages as a single message.
+func (v ValidationErrors) Message() string {
+	messages := make([]string, 0, len(v.Errors))
+	for _, err := range v.Errors {
+		messages = append(messages, err.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func NewValidationErrors(errors ...ValidationError) *echo.HTTPError {
+	return echo.NewHTTPError(http.StatusBadRequest, ValidationErrors{
+		Errors: errors,
+	})
+}
+`, packageName)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -96,12 +97,21 @@ func run(cmd *cli.Context) error {
 	}
 
 	var schemas []*lex.Schema
+	extras := make(map[string]*defExtras)
 	for _, schemaFile := range foundSchemaFiles {
 		s, err := lex.ReadSchema(schemaFile)
 		if err != nil {
 			return fmt.Errorf("failed to read schemas: %w", err)
 		}
 		schemas = append(schemas, s)
+
+		id, defs, err := readDefExtras(schemaFile)
+		if err != nil {
+			return fmt.Errorf("failed to read schema extras: %w", err)
+		}
+		if main, ok := defs["main"]; ok {
+			extras[id] = main
+		}
 	}
 
 	if err := os.Mkdir(args.OutPath, 0744); err != nil {
@@ -136,23 +146,46 @@ func run(cmd *cli.Context) error {
 	}
 
 	for id, def := range queryDefs {
+		contents, err := generateQueryHandler(id, def, extras[id], args.PackageName, args.LexgenPackageName)
+		if err != nil {
+			return fmt.Errorf("failed to generate query handler for %s: %w", id, err)
+		}
 		filename := strings.ToLower(getName(id)) + ".go"
 		filepath := args.OutPath + "/" + filename
-		if err := os.WriteFile(filepath, []byte(generateHandler("get", id, def, args.PackageName)), 0644); err != nil {
+		if err := os.WriteFile(filepath, []byte(contents), 0644); err != nil {
 			return fmt.Errorf("failed to generate file: %w", err)
 		}
 	}
 
 	for id, def := range procedureDefs {
+		contents, err := generateProcedureHandler(id, def, extras[id], args.PackageName, args.LexgenPackageUrl, args.LexgenPackageName)
+		if err != nil {
+			return fmt.Errorf("failed to generate procedure handler for %s: %w", id, err)
+		}
 		filename := strings.ToLower(getName(id)) + ".go"
 		filepath := args.OutPath + "/" + filename
-		if err := os.WriteFile(filepath, []byte(generateHandler("post", id, def, args.PackageName)), 0644); err != nil {
+		if err := os.WriteFile(filepath, []byte(contents), 0644); err != nil {
 			return fmt.Errorf("failed to generate file: %w", err)
 		}
 	}
 
+	mainContents, err := generateMain(args.PackageName, args.LexgenPackageUrl, args.LexgenPackageName, queryDefs, procedureDefs)
+	if err != nil {
+		return fmt.Errorf("failed to generate main handlers file: %w", err)
+	}
+
 	filepath := args.OutPath + "/" + args.PackageName + ".go"
-	if err := os.WriteFile(filepath, []byte(generateMain(args.PackageName, args.LexgenPackageUrl, args.LexgenPackageName, queryDefs, procedureDefs)), 0644); err != nil {
+	if err := os.WriteFile(filepath, []byte(mainContents), 0644); err != nil {
+		return fmt.Errorf("failed to generate file: %w", err)
+	}
+
+	filepath = args.OutPath + "/validation.go"
+	if err := os.WriteFile(filepath, []byte(generateValidationFile(args.PackageName)), 0644); err != nil {
+		return fmt.Errorf("failed to generate file: %w", err)
+	}
+
+	filepath = args.OutPath + "/errors.go"
+	if err := os.WriteFile(filepath, []byte(generateErrorsFile(args.PackageName)), 0644); err != nil {
 		return fmt.Errorf("failed to generate file: %w", err)
 	}
 
@@ -182,6 +215,30 @@ func findSchemas(dir string, out []string) ([]string, error) {
 	return out, nil
 }
 
+// The parts of a lexicon def that lex.TypeSchema doesn't carry, read separately from the schema file.
+type defExtras struct {
+	Parameters *paramsSchema  `json:"parameters"`
+	Errors     []lexiconError `json:"errors"`
+}
+
+// Reads the extras for each def in the given lexicon file, keyed by def name.
+func readDefExtras(path string) (string, map[string]*defExtras, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read lexicon file: %w", err)
+	}
+
+	var file struct {
+		ID   string                `json:"id"`
+		Defs map[string]*defExtras `json:"defs"`
+	}
+	if err := json.Unmarshal(b, &file); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal lexicon file: %w", err)
+	}
+
+	return file.ID, file.Defs, nil
+}
+
 func capitalizeFirst(str string) string {
 	return strings.ToUpper(str[:1]) + str[1:]
 }
@@ -205,11 +262,14 @@ func getTypePartsFromRef(ref string) (string, string) {
 	return getName(nsid), capitalizeFirst(refName)
 }
 
-func generateHandler(method string, id string, def *lex.TypeSchema, packageName string) string {
+func generateQueryHandler(id string, def *lex.TypeSchema, extras *defExtras, packageName, lexgenPackageName string) (string, error) {
 	name := getName(id)
-	structTagPrefix := "query"
-	if method == "post" {
-		structTagPrefix = "json"
+	params, lexErrors := extrasParts(extras)
+	outputTypeStr := outputType(id, def, lexgenPackageName)
+
+	imports := []string{`"net/http"`}
+	if validationNeedsQuery(params) {
+		imports = append(imports, `"net/url"`)
 	}
 
 	contents := fmt.Sprintf(`// GENERATED CODE - DO NOT MODIFY
@@ -218,58 +278,267 @@ func generateHandler(method string, id string, def *lex.TypeSchema, packageName
 package %s
 
 import (
-	"net/http"
+	%s
 
 	"github.com/labstack/echo/v4"
 )
 
 type %sInput struct {
-`, packageName, name)
-	_ = contents
+`, packageName, strings.Join(imports, "\n\t"), name)
+
+	contents += generateParamsFields(def.Parameters)
+
+	contents += fmt.Sprintf(`}
+
+func (h *%s) Handle%s(e echo.Context) error {
+	var input %sInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "Handle%s")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+`, capitalizeFirst(packageName), name, name, name)
+
+	if hasValidation(params) {
+		contents += fmt.Sprintf(`
+	if errs := %s; len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+`, validateCall("input", params))
+	}
 
-	sortedParamNames := make([]string, 0, len(def.Parameters.Properties))
-	for paramName := range def.Parameters.Properties {
+	if outputTypeStr != "" {
+		contents += fmt.Sprintf(`
+	output, err := h.server.Handle%s(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+`, name)
+	} else {
+		contents += fmt.Sprintf(`
+	if err := h.server.Handle%s(e, &input); err != nil {
+		return err
+	}
+
+	return e.NoContent(http.StatusOK)
+}
+`, name)
+	}
+
+	if hasValidation(params) {
+		validate, err := generateValidateMethod(name+"Input", params)
+		if err != nil {
+			return "", err
+		}
+		contents += validate
+	}
+
+	contents += generateErrorConstants(name, lexErrors)
+
+	return contents, nil
+}
+
+func extrasParts(extras *defExtras) (*paramsSchema, []lexiconError) {
+	if extras == nil {
+		return nil, nil
+	}
+	return extras.Parameters, extras.Errors
+}
+
+// Generates the query string bound struct fields for the given lexicon parameters.
+func generateParamsFields(params *lex.TypeSchema) string {
+	if params == nil {
+		return ""
+	}
+
+	sortedParamNames := make([]string, 0, len(params.Properties))
+	for paramName := range params.Properties {
 		sortedParamNames = append(sortedParamNames, paramName)
 	}
 	sort.Slice(sortedParamNames, func(i, j int) bool {
 		return sortedParamNames[j] > sortedParamNames[i]
 	})
 
+	contents := ""
 	for _, paramName := range sortedParamNames {
-		subDef := def.Parameters.Properties[paramName]
+		subDef := params.Properties[paramName]
 		var typeStr = typeToTypeStr(subDef)
-		structTag := structTagPrefix + ":" + "\"" + paramName
-		if !slices.Contains(def.Parameters.Required, paramName) {
+		structTag := "query:\"" + paramName + "\""
+		if !slices.Contains(params.Required, paramName) {
 			typeStr = "*" + typeStr
-			if method == "post" {
-				structTag += "omitempty"
-			}
 		}
-		structTag += "\""
 
 		contents += fmt.Sprintf("\t%s %s `%s`\n", capitalizeFirst(paramName), typeStr, structTag)
 	}
 
-	contents += fmt.Sprintf(`}
+	return contents
+}
+
+func hasParams(def *lex.TypeSchema) bool {
+	return def.Parameters != nil && len(def.Parameters.Properties) > 0
+}
+
+// Returns the Go type of a procedure's input, and whether the input is a raw body (i.e. a blob upload) rather
+// than JSON. An empty type means the procedure takes no input.
+func procedureInputType(id string, def *lex.TypeSchema, lexgenPackageName string) (string, bool, error) {
+	if def.Input == nil {
+		return "", false, nil
+	}
+
+	switch def.Input.Encoding {
+	case lex.EncodingJSON:
+		if def.Input.Schema != nil && def.Input.Schema.Type == "ref" {
+			refNsidName, refTypeName := getTypePartsFromRef(def.Input.Schema.Ref)
+			return "*" + lexgenPackageName + "." + refNsidName + "_" + refTypeName, false, nil
+		}
+		return "*" + lexgenPackageName + "." + getName(id) + "_Input", false, nil
+	case lex.EncodingANY, lex.EncodingCBOR, lex.EncodingCAR:
+		return "io.Reader", true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported input encoding %q", def.Input.Encoding)
+	}
+}
+
+// Returns the Go type of a query or procedure's JSON output. An empty type means there is no output body.
+func outputType(id string, def *lex.TypeSchema, lexgenPackageName string) string {
+	if def.Output == nil || def.Output.Schema == nil {
+		return ""
+	}
 
+	switch def.Output.Schema.Type {
+	case "object":
+		return lexgenPackageName + "." + getName(id) + "_Output"
+	case "ref":
+		refNsidName, refTypeName := getTypePartsFromRef(def.Output.Schema.Ref)
+		return lexgenPackageName + "." + refNsidName + "_" + refTypeName
+	}
+
+	return ""
+}
+
+func generateProcedureHandler(id string, def *lex.TypeSchema, extras *defExtras, packageName, lexgenPackageUrl, lexgenPackageName string) (string, error) {
+	name := getName(id)
+	params, lexErrors := extrasParts(extras)
+
+	inputTypeStr, isBlob, err := procedureInputType(id, def, lexgenPackageName)
+	if err != nil {
+		return "", err
+	}
+	outputTypeStr := outputType(id, def, lexgenPackageName)
+
+	imports := []string{`"net/http"`}
+	if inputTypeStr != "" && !isBlob {
+		imports = append(imports, `"encoding/json"`)
+	}
+	if validationNeedsQuery(params) {
+		imports = append(imports, `"net/url"`)
+	}
+	sort.Strings(imports)
+
+	thirdPartyImports := []string{`"github.com/labstack/echo/v4"`}
+	if inputTypeStr != "" && !isBlob {
+		thirdPartyImports = append(thirdPartyImports, fmt.Sprintf(`%s "%s"`, lexgenPackageName, lexgenPackageUrl))
+	}
+
+	contents := fmt.Sprintf(`// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package %s
+
+import (
+	%s
+
+	%s
+)
+`, packageName, strings.Join(imports, "\n\t"), strings.Join(thirdPartyImports, "\n\t"))
+
+	if hasParams(def) {
+		contents += fmt.Sprintf(`
+type %sParams struct {
+%s}
+`, name, generateParamsFields(def.Parameters))
+	}
+
+	contents += fmt.Sprintf(`
 func (h *%s) Handle%s(e echo.Context) error {
-	var input %sInput
-	if err := e.Bind(&input); err != nil {
-		logger := h.server.Logger().With("handler", "Handle%s")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+`, capitalizeFirst(packageName), name)
+
+	// statement blocks of the handler body, separated by blank lines
+	var blocks []string
+
+	if hasParams(def) || (inputTypeStr != "" && !isBlob) {
+		blocks = append(blocks, fmt.Sprintf(`	logger := h.server.Logger().With("handler", "Handle%s")
+`, name))
 	}
 
-	output, err := h.server.Handle%s(e, &input)
+	args := []string{"e"}
+
+	if hasParams(def) {
+		blocks = append(blocks, fmt.Sprintf(`	var params %sParams
+	if err := (&echo.DefaultBinder{}).BindQueryParams(e, &params); err != nil {
+		logger.Warn("error binding query params", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+`, name))
+		if hasValidation(params) {
+			blocks = append(blocks, fmt.Sprintf(`	if errs := %s; len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+`, validateCall("params", params)))
+		}
+		args = append(args, "&params")
+	}
+
+	if inputTypeStr != "" {
+		if isBlob {
+			blocks = append(blocks, `	input := e.Request().Body
+	defer input.Close()
+`)
+			args = append(args, "input")
+		} else {
+			blocks = append(blocks, fmt.Sprintf(`	var input %s
+	if err := json.NewDecoder(e.Request().Body).Decode(&input); err != nil {
+		logger.Warn("error decoding request body", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid request body")
+	}
+`, strings.TrimPrefix(inputTypeStr, "*")))
+			args = append(args, "&input")
+		}
+	}
+
+	if outputTypeStr != "" {
+		blocks = append(blocks, fmt.Sprintf(`	output, err := h.server.Handle%s(%s)
 	if err != nil {
 		return err
 	}
 
 	return e.JSON(http.StatusOK, &output)
-}
-`, capitalizeFirst(packageName), name, name, name, name)
+`, name, strings.Join(args, ", ")))
+	} else {
+		blocks = append(blocks, fmt.Sprintf(`	if err := h.server.Handle%s(%s); err != nil {
+		return err
+	}
 
-	return contents
+	return e.NoContent(http.StatusOK)
+`, name, strings.Join(args, ", ")))
+	}
+
+	contents += strings.Join(blocks, "\n") + "}\n"
+
+	if hasValidation(params) {
+		validate, err := generateValidateMethod(name+"Params", params)
+		if err != nil {
+			return "", err
+		}
+		contents += validate
+	}
+
+	contents += generateErrorConstants(name, lexErrors)
+
+	return contents, nil
 }
 
 func typeToTypeStr(def *lex.TypeSchema) string {
@@ -286,7 +555,7 @@ func typeToTypeStr(def *lex.TypeSchema) string {
 	return ""
 }
 
-func generateMain(packageName, lexgenPackageUrl, lexgenPackageName string, queryDefs map[string]*lex.TypeSchema, procedureDefs map[string]*lex.TypeSchema) string {
+func generateMain(packageName, lexgenPackageUrl, lexgenPackageName string, queryDefs map[string]*lex.TypeSchema, procedureDefs map[string]*lex.TypeSchema) (string, error) {
 	queryIds := make([]string, 0, len(queryDefs))
 	procedureIds := make([]string, 0, len(procedureDefs))
 	for id := range queryDefs {
@@ -302,14 +571,32 @@ func generateMain(packageName, lexgenPackageUrl, lexgenPackageName string, query
 		return procedureIds[j] > procedureIds[i]
 	})
 
+	usesReader := false
+	for _, id := range procedureIds {
+		_, isBlob, err := procedureInputType(id, procedureDefs[id], lexgenPackageName)
+		if err != nil {
+			return "", fmt.Errorf("failed to get input type for %s: %w", id, err)
+		}
+		if isBlob {
+			usesReader = true
+		}
+	}
+
+	stdImports := `"log/slog"
+	"net/http"`
+	if usesReader {
+		stdImports = `"io"
+	"log/slog"
+	"net/http"`
+	}
+
 	contents := fmt.Sprintf(`// GENERATED CODE - DO NOT MODIFY
 // Generated by vylet-app/handlergen
 
 package %s
 
 import (
-	"log/slog"
-	"net/http"
+	%s
 
 	"github.com/labstack/echo/v4"
 	%s "%s"
@@ -318,45 +605,42 @@ import (
 type Server interface {
 	Logger() *slog.Logger
 
-`, packageName, lexgenPackageName, lexgenPackageUrl)
+`, packageName, stdImports, lexgenPackageName, lexgenPackageUrl)
 
-	addHandlerToInterface := func(id string, refName string) {
+	for _, id := range queryIds {
 		name := getName(id)
-		handlerName := "Handle" + name
-		inputTypeName := name + "Input"
-		requiresAuthName := name + "RequiresAuth"
 
-		var outputTypeName string
-		if refName != "" {
-			refNsidName, refTypeName := getTypePartsFromRef(refName)
-			outputTypeName = lexgenPackageName + "." + refNsidName + "_" + refTypeName
-		} else {
-			outputTypeName = lexgenPackageName + "." + name + "_Output"
+		returns := "*echo.HTTPError"
+		if outputTypeName := outputType(id, queryDefs[id], lexgenPackageName); outputTypeName != "" {
+			returns = fmt.Sprintf("(*%s, *echo.HTTPError)", outputTypeName)
 		}
 
-		contents += fmt.Sprintf(`	%s(e echo.Context, input *%s) (*%s, *echo.HTTPError)
-	%s() bool
-`, handlerName, inputTypeName, outputTypeName, requiresAuthName)
+		contents += fmt.Sprintf(`	Handle%s(e echo.Context, input *%sInput) %s
+	%sRequiresAuth() bool
+`, name, name, returns, name)
 	}
 
-	for _, id := range queryIds {
-		schema := queryDefs[id]
-		switch schema.Output.Schema.Type {
-		case "object":
-			addHandlerToInterface(id, "")
-		case "ref":
-			addHandlerToInterface(id, schema.Output.Schema.Ref)
+	for _, id := range procedureIds {
+		def := procedureDefs[id]
+		name := getName(id)
+
+		args := []string{"e echo.Context"}
+		if hasParams(def) {
+			args = append(args, fmt.Sprintf("params *%sParams", name))
+		}
+		inputTypeName, _, _ := procedureInputType(id, def, lexgenPackageName)
+		if inputTypeName != "" {
+			args = append(args, "input "+inputTypeName)
 		}
-	}
 
-	for _, id := range procedureIds {
-		schema := procedureDefs[id]
-		switch schema.Output.Schema.Type {
-		case "object":
-			addHandlerToInterface(id, "")
-		case "ref":
-			addHandlerToInterface(id, schema.Output.Schema.Ref)
+		returns := "*echo.HTTPError"
+		if outputTypeName := outputType(id, def, lexgenPackageName); outputTypeName != "" {
+			returns = fmt.Sprintf("(*%s, *echo.HTTPError)", outputTypeName)
 		}
+
+		contents += fmt.Sprintf(`	Handle%s(%s) %s
+	%sRequiresAuth() bool
+`, name, strings.Join(args, ", "), returns, name)
 	}
 
 	contents += `}
@@ -394,7 +678,7 @@ func AuthRequiredMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(e echo.Context) error {
 		viewer, ok := e.Get("viewer").(string)
 		if !ok || viewer == "" {
-			return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+			return NewXRPCError(http.StatusUnauthorized, ErrorAuthRequired, "Authentication required")
 		}
 		return next(e)
 	}
@@ -413,5 +697,5 @@ func CreateAuthRequiredMiddleware(authRequired bool) echo.MiddlewareFunc {
 }
 `
 
-	return contents
+	return contents, nil
 }
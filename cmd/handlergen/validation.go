@@ -0,0 +1,304 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// The subset of a lexicon's parameter schema that is used to generate validation. lex.TypeSchema doesn't
+// carry string formats, knownValues, or minLength, so these are read separately by readDefExtras.
+type paramsSchema struct {
+	Required   []string                `json:"required"`
+	Properties map[string]*paramSchema `json:"properties"`
+}
+
+type paramSchema struct {
+	Type        string       `json:"type"`
+	Format      string       `json:"format"`
+	KnownValues []string     `json:"knownValues"`
+	Enum        []string     `json:"enum"`
+	MinLength   *int         `json:"minLength"`
+	MaxLength   *int         `json:"maxLength"`
+	Minimum     *int64       `json:"minimum"`
+	Maximum     *int64       `json:"maximum"`
+	Default     any          `json:"default"`
+	Items       *paramSchema `json:"items"`
+}
+
+// Human readable descriptions of the string formats, used in validation messages.
+var formatDescriptions = map[string]string{
+	"at-identifier": "DID or handle",
+	"at-uri":        "AT-URI",
+	"cid":           "CID",
+	"datetime":      "datetime",
+	"did":           "DID",
+	"handle":        "handle",
+	"language":      "language tag",
+	"nsid":          "NSID",
+	"record-key":    "record key",
+	"tid":           "TID",
+	"uri":           "URI",
+}
+
+func hasValidation(params *paramsSchema) bool {
+	return params != nil && len(params.Properties) > 0
+}
+
+// Reports whether the validate method needs the raw query string, which is only the case for required parameters
+// whose zero value can't be told apart from a missing value.
+func validationNeedsQuery(params *paramsSchema) bool {
+	if params == nil {
+		return false
+	}
+	for _, paramName := range params.Required {
+		schema, ok := params.Properties[paramName]
+		if ok && schema.Type != "string" && schema.Type != "array" {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the call to the validate method on the given receiver
+func validateCall(receiver string, params *paramsSchema) string {
+	if validationNeedsQuery(params) {
+		return receiver + ".validate(e.QueryParams())"
+	}
+	return receiver + ".validate()"
+}
+
+// Generates a validate method for the given bound parameters struct. Alongside checking the lexicon constraints,
+// the method fills in the lexicon's default for any optional parameter that was not supplied.
+func generateValidateMethod(typeName string, params *paramsSchema) (string, error) {
+	sortedParamNames := make([]string, 0, len(params.Properties))
+	for paramName := range params.Properties {
+		sortedParamNames = append(sortedParamNames, paramName)
+	}
+	sort.Strings(sortedParamNames)
+
+	var blocks []string
+	for _, paramName := range sortedParamNames {
+		block, err := generateParamValidation(paramName, params.Properties[paramName], slices.Contains(params.Required, paramName))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate validation for parameter %q: %w", paramName, err)
+		}
+		if block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	signature := "validate()"
+	if validationNeedsQuery(params) {
+		signature = "validate(query url.Values)"
+	}
+
+	contents := fmt.Sprintf(`
+func (input *%s) %s []ValidationError {
+	var errs []ValidationError
+
+`, typeName, signature)
+
+	contents += strings.Join(blocks, "\n")
+
+	if len(blocks) > 0 {
+		contents += "\n"
+	}
+
+	contents += `	return errs
+}
+`
+
+	return contents, nil
+}
+
+func generateParamValidation(paramName string, schema *paramSchema, required bool) (string, error) {
+	field := "input." + capitalizeFirst(paramName)
+
+	// Required parameters are bound to values rather than pointers, so their zero value is the only indication
+	// that they were missing (or, for anything other than strings and arrays, the query string itself).
+	var missing, present, value string
+	switch {
+	case required && schema.Type == "string":
+		missing, present = field+` == ""`, field+` != ""`
+		value = field
+	case required && schema.Type == "array":
+		missing, present = "len("+field+") == 0", "len("+field+") > 0"
+		value = field
+	case required:
+		missing, present = fmt.Sprintf("!query.Has(%q)", paramName), fmt.Sprintf("query.Has(%q)", paramName)
+		value = field
+	default:
+		missing, present = field+" == nil", field+" != nil"
+		value = "*" + field
+	}
+
+	checks, err := generateValueChecks(paramName, paramName, value, schema, "\t\t", false)
+	if err != nil {
+		return "", err
+	}
+
+	var onMissing string
+	switch {
+	case required:
+		onMissing = fmt.Sprintf("\t\terrs = append(errs, ValidationError{Field: %q, Message: %q})\n", paramName, paramName+" is required")
+	case schema.Default != nil:
+		literal, err := defaultLiteral(schema)
+		if err != nil {
+			return "", err
+		}
+		onMissing = fmt.Sprintf("\t\tdefault%s := %s\n\t\t%s = &default%s\n", capitalizeFirst(paramName), literal, field, capitalizeFirst(paramName))
+	}
+
+	switch {
+	case onMissing != "" && checks != "":
+		return fmt.Sprintf("\tif %s {\n%s\t} else {\n%s\t}\n", missing, onMissing, checks), nil
+	case onMissing != "":
+		return fmt.Sprintf("\tif %s {\n%s\t}\n", missing, onMissing), nil
+	case checks != "":
+		return fmt.Sprintf("\tif %s {\n%s\t}\n", present, checks), nil
+	}
+
+	return "", nil
+}
+
+// Generates the checks for a value that is known to be present. Label is how the value is referred to in messages.
+// Checks on array items stop at the first invalid item, so a single bad parameter only reports one error.
+func generateValueChecks(paramName, label, value string, schema *paramSchema, indent string, inLoop bool) (string, error) {
+	var checks []string
+
+	appendErr := func(message string) string {
+		line := fmt.Sprintf("%s\terrs = append(errs, ValidationError{Field: %q, Message: %q})\n", indent, paramName, message)
+		if inLoop {
+			line += indent + "\tbreak\n"
+		}
+		return line
+	}
+
+	switch schema.Type {
+	case "string":
+		if schema.Format != "" {
+			description, ok := formatDescriptions[schema.Format]
+			if !ok {
+				return "", fmt.Errorf("unsupported string format %q", schema.Format)
+			}
+			checks = append(checks, fmt.Sprintf("%sif !validFormat(%q, %s) {\n%s%s}\n", indent, schema.Format, value, appendErr(fmt.Sprintf("%s must be a valid %s", label, description)), indent))
+		}
+		if schema.MinLength != nil {
+			checks = append(checks, fmt.Sprintf("%sif len(%s) < %d {\n%s%s}\n", indent, value, *schema.MinLength, appendErr(fmt.Sprintf("%s must be at least %d bytes long", label, *schema.MinLength)), indent))
+		}
+		if schema.MaxLength != nil {
+			checks = append(checks, fmt.Sprintf("%sif len(%s) > %d {\n%s%s}\n", indent, value, *schema.MaxLength, appendErr(fmt.Sprintf("%s must be at most %d bytes long", label, *schema.MaxLength)), indent))
+		}
+		if allowed := allowedValues(schema); len(allowed) > 0 {
+			quoted := make([]string, 0, len(allowed))
+			for _, v := range allowed {
+				quoted = append(quoted, fmt.Sprintf("%q", v))
+			}
+			checks = append(checks, fmt.Sprintf("%sswitch %s {\n%scase %s:\n%sdefault:\n%s%s}\n", indent, value, indent, strings.Join(quoted, ", "), indent, appendErr(fmt.Sprintf("%s must be one of: %s", label, strings.Join(allowed, ", "))), indent))
+		}
+	case "integer":
+		switch {
+		case schema.Minimum != nil && schema.Maximum != nil:
+			checks = append(checks, fmt.Sprintf("%sif %s < %d || %s > %d {\n%s%s}\n", indent, value, *schema.Minimum, value, *schema.Maximum, appendErr(fmt.Sprintf("%s must be between %d and %d", label, *schema.Minimum, *schema.Maximum)), indent))
+		case schema.Minimum != nil:
+			checks = append(checks, fmt.Sprintf("%sif %s < %d {\n%s%s}\n", indent, value, *schema.Minimum, appendErr(fmt.Sprintf("%s must be at least %d", label, *schema.Minimum)), indent))
+		case schema.Maximum != nil:
+			checks = append(checks, fmt.Sprintf("%sif %s > %d {\n%s%s}\n", indent, value, *schema.Maximum, appendErr(fmt.Sprintf("%s must be at most %d", label, *schema.Maximum)), indent))
+		}
+	case "array":
+		if schema.MinLength != nil {
+			checks = append(checks, fmt.Sprintf("%sif len(%s) < %d {\n%s%s}\n", indent, value, *schema.MinLength, appendErr(fmt.Sprintf("%s must contain at least %d items", label, *schema.MinLength)), indent))
+		}
+		if schema.MaxLength != nil {
+			checks = append(checks, fmt.Sprintf("%sif len(%s) > %d {\n%s%s}\n", indent, value, *schema.MaxLength, appendErr(fmt.Sprintf("%s must contain at most %d items", label, *schema.MaxLength)), indent))
+		}
+		if schema.Items != nil {
+			itemChecks, err := generateValueChecks(paramName, "each item in "+paramName, "item", schema.Items, indent+"\t", true)
+			if err != nil {
+				return "", err
+			}
+			if itemChecks != "" {
+				checks = append(checks, fmt.Sprintf("%sfor _, item := range %s {\n%s%s}\n", indent, value, itemChecks, indent))
+			}
+		}
+	}
+
+	return strings.Join(checks, ""), nil
+}
+
+func allowedValues(schema *paramSchema) []string {
+	if len(schema.Enum) > 0 {
+		return schema.Enum
+	}
+	return schema.KnownValues
+}
+
+// Returns a Go literal for the parameter's default value.
+func defaultLiteral(schema *paramSchema) (string, error) {
+	switch schema.Type {
+	case "integer":
+		num, ok := schema.Default.(float64)
+		if !ok {
+			return "", fmt.Errorf("integer default has unexpected type %T", schema.Default)
+		}
+		return fmt.Sprintf("int64(%d)", int64(num)), nil
+	case "string":
+		str, ok := schema.Default.(string)
+		if !ok {
+			return "", fmt.Errorf("string default has unexpected type %T", schema.Default)
+		}
+		return fmt.Sprintf("%q", str), nil
+	case "boolean":
+		b, ok := schema.Default.(bool)
+		if !ok {
+			return "", fmt.Errorf("boolean default has unexpected type %T", schema.Default)
+		}
+		return fmt.Sprintf("%t", b), nil
+	}
+
+	return "", fmt.Errorf("defaults are not supported for %s parameters", schema.Type)
+}
+
+func generateValidationFile(packageName string) string {
+	return fmt.Sprintf(`// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package %s
+
+import (
+	"github.com/bluesky-social/indigo/atproto/syntax"
+)
+
+// Reports whether the value is valid for the given lexicon string format.
+func validFormat(format, value string) bool {
+	var err error
+	switch format {
+	case "at-identifier":
+		_, err = syntax.ParseAtIdentifier(value)
+	case "at-uri":
+		_, err = syntax.ParseATURI(value)
+	case "cid":
+		_, err = syntax.ParseCID(value)
+	case "datetime":
+		_, err = syntax.ParseDatetime(value)
+	case "did":
+		_, err = syntax.ParseDID(value)
+	case "handle":
+		_, err = syntax.ParseHandle(value)
+	case "language":
+		_, err = syntax.ParseLanguage(value)
+	case "nsid":
+		_, err = syntax.ParseNSID(value)
+	case "record-key":
+		_, err = syntax.ParseRecordKey(value)
+	case "tid":
+		_, err = syntax.ParseTID(value)
+	case "uri":
+		_, err = syntax.ParseURI(value)
+	}
+	return err == nil
+}
+`, packageName)
+}
@@ -9,13 +9,14 @@ import (
 	"github.com/bluesky-social/go-util/pkg/telemetry"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/urfave/cli/v2"
+	"github.com/vylet-app/go/database/client"
 	"github.com/vylet-app/go/indexer"
 )
 
 func main() {
 	app := cli.App{
 		Name: "vylet-database",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			telemetry.CLIFlagDebug,
 			telemetry.CLIFlagMetricsListenAddress,
 			&cli.StringFlag{
@@ -38,7 +39,23 @@ func main() {
 				Required: true,
 				EnvVars:  []string{"VYLET_INDEXER_CONSUMER_GROUP"},
 			},
-		},
+			&cli.StringFlag{
+				Name:    "lexicons-path",
+				Usage:   "path to the lexicons that incoming records are validated against. if not set, records are not validated",
+				EnvVars: []string{"VYLET_INDEXER_LEXICONS_PATH"},
+			},
+			&cli.StringFlag{
+				Name:    "invalid-record-action",
+				Usage:   "what to do with records that fail validation: reject, quarantine, or allow",
+				Value:   indexer.InvalidRecordActionReject,
+				EnvVars: []string{"VYLET_INDEXER_INVALID_RECORD_ACTION"},
+			},
+			&cli.StringFlag{
+				Name:    "quarantine-topic",
+				Usage:   "topic that invalid records are produced to when the invalid record action is quarantine",
+				EnvVars: []string{"VYLET_INDEXER_QUARANTINE_TOPIC"},
+			},
+		}, client.Flags("VYLET_INDEXER")...),
 		Action: run,
 	}
 
@@ -53,12 +70,21 @@ func run(cmd *cli.Context) error {
 	logger := telemetry.StartLogger(cmd)
 	telemetry.StartMetrics(cmd)
 
-	server, err := indexer.New(&indexer.Args{
-		Logger:           logger,
-		BootstrapServers: cmd.StringSlice("bootstrap-servers"),
-		InputTopic:       cmd.String("input-topic"),
-		ConsumerGroup:    cmd.String("consumer-group"),
-		DatabaseHost:     cmd.String("database-host"),
+	dbOptions, err := client.OptionsFromCLI(cmd)
+	if err != nil {
+		return err
+	}
+
+	server, err := indexer.New(ctx, &indexer.Args{
+		Logger:              logger,
+		BootstrapServers:    cmd.StringSlice("bootstrap-servers"),
+		InputTopic:          cmd.String("input-topic"),
+		ConsumerGroup:       cmd.String("consumer-group"),
+		DatabaseHost:        cmd.String("database-host"),
+		DatabaseOptions:     dbOptions,
+		LexiconsPath:        cmd.String("lexicons-path"),
+		InvalidRecordAction: cmd.String("invalid-record-action"),
+		QuarantineTopic:     cmd.String("quarantine-topic"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create new server: %w", err)
@@ -0,0 +1,165 @@
+// Package cassandra configures the Cassandra sessions used by the database server and the migrate tool
+package cassandra
+
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+type Config struct {
+	Addrs    []string
+	Keyspace string
+
+	// Credentials for password authentication. If empty, no authentication is used.
+	Username string
+	Password string
+
+	TLS TLSConfig
+
+	// Data center whose nodes queries are sent to, falling back to other data centers only when none of its nodes
+	// are available. If empty, queries are sent to every node round robin.
+	LocalDC string
+	// Send each query to a replica of the partition it reads or writes, saving a hop through a coordinator. Off by
+	// default, which sends queries round robin as the server always has.
+	TokenAware bool
+
+	ProtoVersion   int
+	ConnectTimeout time.Duration
+	Timeout        time.Duration
+
+	ReadConsistency   gocql.Consistency
+	WriteConsistency  gocql.Consistency
+	SerialConsistency gocql.SerialConsistency
+
+	// Number of extra attempts made at a read that hasn't completed after SpeculativeDelay, each to another node.
+	// Zero disables speculative execution.
+	SpeculativeAttempts int
+	SpeculativeDelay    time.Duration
+
+	// Number of times a failed read is retried, with exponential backoff between RetryMinBackoff and
+	// RetryMaxBackoff. Writes are never retried by the driver, since some of them, such as counter updates, aren't
+	// safe to apply twice.
+	RetryAttempts   int
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+}
+
+type TLSConfig struct {
+	Enabled bool
+	// PEM CA bundle the nodes' certificates are verified against. If empty, the system roots are used.
+	CAFile string
+	// PEM client certificate and key, for nodes that require client authentication
+	CertFile string
+	KeyFile  string
+	// Name the nodes' certificates are checked against. If empty, each node's address is used.
+	ServerName string
+	// Skip verifying the nodes' certificates
+	InsecureSkipVerify bool
+}
+
+// Returns the configuration the database server and migrate tool have always used, a quorum of every replica across
+// all data centers over protocol version 4. Deployments with several data centers usually want LOCAL_QUORUM instead,
+// so that requests don't wait on replicas in other data centers.
+func DefaultConfig() Config {
+	return Config{
+		Addrs:             []string{"127.0.0.1"},
+		Keyspace:          "vylet",
+		ProtoVersion:      4,
+		ConnectTimeout:    10 * time.Second,
+		Timeout:           10 * time.Second,
+		ReadConsistency:   gocql.Quorum,
+		WriteConsistency:  gocql.Quorum,
+		SerialConsistency: gocql.Serial,
+		SpeculativeDelay:  100 * time.Millisecond,
+		RetryMinBackoff:   100 * time.Millisecond,
+		RetryMaxBackoff:   time.Second,
+	}
+}
+
+// Returns the cluster configuration. Queries use the write consistency unless they're made reads with Read.
+func (c *Config) Cluster() (*gocql.ClusterConfig, error) {
+	if len(c.Addrs) == 0 {
+		return nil, fmt.Errorf("no cassandra addresses configured")
+	}
+
+	cluster := gocql.NewCluster(c.Addrs...)
+	cluster.Keyspace = c.Keyspace
+	cluster.Consistency = c.WriteConsistency
+	cluster.SerialConsistency = c.SerialConsistency
+	cluster.ProtoVersion = c.ProtoVersion
+	cluster.ConnectTimeout = c.ConnectTimeout
+	cluster.Timeout = c.Timeout
+
+	if c.Username != "" {
+		cluster.Authenticator = gocql.PasswordAuthenticator{
+			Username: c.Username,
+			Password: c.Password,
+		}
+	}
+
+	if c.TLS.Enabled {
+		cluster.SslOpts = &gocql.SslOptions{
+			Config: &tls.Config{
+				ServerName:         c.TLS.ServerName,
+				InsecureSkipVerify: c.TLS.InsecureSkipVerify,
+				MinVersion:         tls.VersionTLS12,
+			},
+			CaPath:                 c.TLS.CAFile,
+			CertPath:               c.TLS.CertFile,
+			KeyPath:                c.TLS.KeyFile,
+			EnableHostVerification: !c.TLS.InsecureSkipVerify,
+		}
+	}
+
+	fallback := gocql.RoundRobinHostPolicy()
+	if c.LocalDC != "" {
+		fallback = gocql.DCAwareRoundRobinPolicy(c.LocalDC)
+	}
+	if c.TokenAware {
+		cluster.PoolConfig.HostSelectionPolicy = gocql.TokenAwareHostPolicy(fallback)
+	} else {
+		cluster.PoolConfig.HostSelectionPolicy = fallback
+	}
+
+	return cluster, nil
+}
+
+// Connects to the cluster
+func (c *Config) NewSession() (*gocql.Session, error) {
+	cluster, err := c.Cluster()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to cassandra: %w", err)
+	}
+
+	return session, nil
+}
+
+// Makes a query a read, which uses the read consistency and may be executed speculatively and retried
+func (c *Config) Read(q *gocql.Query) *gocql.Query {
+	q = q.Consistency(c.ReadConsistency).Idempotent(true)
+
+	if c.SpeculativeAttempts > 0 {
+		q = q.SetSpeculativeExecutionPolicy(&gocql.SimpleSpeculativeExecution{
+			NumAttempts:  c.SpeculativeAttempts,
+			TimeoutDelay: c.SpeculativeDelay,
+		})
+	}
+
+	if c.RetryAttempts > 0 {
+		q = q.RetryPolicy(&gocql.ExponentialBackoffRetryPolicy{
+			NumRetries: c.RetryAttempts,
+			Min:        c.RetryMinBackoff,
+			Max:        c.RetryMaxBackoff,
+		})
+	}
+
+	return q
+}
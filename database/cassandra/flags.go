@@ -0,0 +1,189 @@
+package cassandra
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/urfave/cli/v2"
+)
+
+// Returns the CLI flags for the Cassandra config, shared by the database server and the migrate tool
+func Flags() []cli.Flag {
+	defaults := DefaultConfig()
+
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:    "cassandra-addrs",
+			Usage:   "comma separated Cassandra hosts",
+			Value:   cli.NewStringSlice(defaults.Addrs...),
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_ADDRS", "VYLET_DATABASE_CASSANDRA_HOSTS"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-keyspace",
+			Aliases: []string{"k"},
+			Usage:   "Cassandra keyspace",
+			Value:   defaults.Keyspace,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_KEYSPACE"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-username",
+			Usage:   "username for Cassandra password authentication",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_USERNAME"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-password",
+			Usage:   "password for Cassandra password authentication",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_PASSWORD"},
+		},
+		&cli.BoolFlag{
+			Name:    "cassandra-tls",
+			Usage:   "connect to Cassandra over TLS",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_TLS"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-ca-file",
+			Usage:   "PEM CA bundle used to verify Cassandra's certificates. the system roots are used if unset",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_CA_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-cert-file",
+			Usage:   "PEM client certificate presented to Cassandra",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_CERT_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-key-file",
+			Usage:   "PEM private key for the Cassandra client certificate",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_KEY_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-server-name",
+			Usage:   "name Cassandra's certificates are verified against, if it differs from each node's address",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_SERVER_NAME"},
+		},
+		&cli.BoolFlag{
+			Name:    "cassandra-tls-insecure-skip-verify",
+			Usage:   "don't verify Cassandra's certificates",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_TLS_INSECURE_SKIP_VERIFY"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-local-dc",
+			Usage:   "data center to send queries to, falling back to others only when none of its nodes are up",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_LOCAL_DC"},
+		},
+		&cli.BoolFlag{
+			Name:    "cassandra-token-aware",
+			Usage:   "send each query to a replica of its partition",
+			Value:   defaults.TokenAware,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_TOKEN_AWARE"},
+		},
+		&cli.IntFlag{
+			Name:    "cassandra-proto-version",
+			Usage:   "native protocol version",
+			Value:   defaults.ProtoVersion,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_PROTO_VERSION"},
+		},
+		&cli.DurationFlag{
+			Name:    "cassandra-connect-timeout",
+			Usage:   "timeout for connecting to a node",
+			Value:   defaults.ConnectTimeout,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_CONNECT_TIMEOUT"},
+		},
+		&cli.DurationFlag{
+			Name:    "cassandra-timeout",
+			Usage:   "timeout for each query attempt",
+			Value:   defaults.Timeout,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_TIMEOUT"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-read-consistency",
+			Usage:   "consistency of reads, e.g. ONE, QUORUM or LOCAL_QUORUM",
+			Value:   defaults.ReadConsistency.String(),
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_READ_CONSISTENCY"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-write-consistency",
+			Usage:   "consistency of writes and schema changes, e.g. ONE, QUORUM or LOCAL_QUORUM",
+			Value:   defaults.WriteConsistency.String(),
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_WRITE_CONSISTENCY"},
+		},
+		&cli.StringFlag{
+			Name:    "cassandra-serial-consistency",
+			Usage:   "consistency of lightweight transactions, SERIAL or LOCAL_SERIAL",
+			Value:   defaults.SerialConsistency.String(),
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_SERIAL_CONSISTENCY"},
+		},
+		&cli.IntFlag{
+			Name:    "cassandra-speculative-attempts",
+			Usage:   "extra attempts made at slow reads, each to another node. 0 disables speculative execution",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_SPECULATIVE_ATTEMPTS"},
+		},
+		&cli.DurationFlag{
+			Name:    "cassandra-speculative-delay",
+			Usage:   "how long a read runs before a speculative attempt is made",
+			Value:   defaults.SpeculativeDelay,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_SPECULATIVE_DELAY"},
+		},
+		&cli.IntFlag{
+			Name:    "cassandra-retry-attempts",
+			Usage:   "times a failed read is retried. writes are never retried",
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_RETRY_ATTEMPTS"},
+		},
+		&cli.DurationFlag{
+			Name:    "cassandra-retry-min-backoff",
+			Usage:   "backoff before the first read retry",
+			Value:   defaults.RetryMinBackoff,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_RETRY_MIN_BACKOFF"},
+		},
+		&cli.DurationFlag{
+			Name:    "cassandra-retry-max-backoff",
+			Usage:   "longest backoff between read retries",
+			Value:   defaults.RetryMaxBackoff,
+			EnvVars: []string{"VYLET_DATABASE_CASSANDRA_RETRY_MAX_BACKOFF"},
+		},
+	}
+}
+
+// Reads the flags returned by Flags
+func ConfigFromCLI(cmd *cli.Context) (Config, error) {
+	readConsistency, err := gocql.ParseConsistencyWrapper(cmd.String("cassandra-read-consistency"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid read consistency: %w", err)
+	}
+	writeConsistency, err := gocql.ParseConsistencyWrapper(cmd.String("cassandra-write-consistency"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid write consistency: %w", err)
+	}
+	var serialConsistency gocql.SerialConsistency
+	if err := serialConsistency.UnmarshalText([]byte(strings.ToUpper(cmd.String("cassandra-serial-consistency")))); err != nil {
+		return Config{}, fmt.Errorf("invalid serial consistency: %w", err)
+	}
+
+	return Config{
+		Addrs:    cmd.StringSlice("cassandra-addrs"),
+		Keyspace: cmd.String("cassandra-keyspace"),
+		Username: cmd.String("cassandra-username"),
+		Password: cmd.String("cassandra-password"),
+		TLS: TLSConfig{
+			Enabled:            cmd.Bool("cassandra-tls"),
+			CAFile:             cmd.String("cassandra-ca-file"),
+			CertFile:           cmd.String("cassandra-cert-file"),
+			KeyFile:            cmd.String("cassandra-key-file"),
+			ServerName:         cmd.String("cassandra-server-name"),
+			InsecureSkipVerify: cmd.Bool("cassandra-tls-insecure-skip-verify"),
+		},
+		LocalDC:             cmd.String("cassandra-local-dc"),
+		TokenAware:          cmd.Bool("cassandra-token-aware"),
+		ProtoVersion:        cmd.Int("cassandra-proto-version"),
+		ConnectTimeout:      cmd.Duration("cassandra-connect-timeout"),
+		Timeout:             cmd.Duration("cassandra-timeout"),
+		ReadConsistency:     readConsistency,
+		WriteConsistency:    writeConsistency,
+		SerialConsistency:   serialConsistency,
+		SpeculativeAttempts: cmd.Int("cassandra-speculative-attempts"),
+		SpeculativeDelay:    cmd.Duration("cassandra-speculative-delay"),
+		RetryAttempts:       cmd.Int("cassandra-retry-attempts"),
+		RetryMinBackoff:     cmd.Duration("cassandra-retry-min-backoff"),
+		RetryMaxBackoff:     cmd.Duration("cassandra-retry-max-backoff"),
+	}, nil
+}
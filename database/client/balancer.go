@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Returns the target to dial for an address and any options it needs. An address may be a single host, which is
+// resolved with DNS so that every replica behind the name is used, a gRPC target such as dns:///db.internal:9090, or
+// a comma separated list of replicas.
+func dialTarget(addr string) (string, []grpc.DialOption) {
+	if !strings.Contains(addr, ",") {
+		return addr, nil
+	}
+
+	var addrs []resolver.Address
+	for _, a := range strings.Split(addr, ",") {
+		a = strings.TrimSpace(a)
+		if a == "" {
+			continue
+		}
+		host, _, err := net.SplitHostPort(a)
+		if err != nil {
+			host = a
+		}
+		addrs = append(addrs, resolver.Address{
+			Addr:       a,
+			ServerName: host,
+		})
+	}
+
+	r := manual.NewBuilderWithScheme("vylet-database")
+	r.InitialState(resolver.State{
+		Addresses: addrs,
+	})
+
+	return r.Scheme() + ":///replicas", []grpc.DialOption{grpc.WithResolvers(r)}
+}
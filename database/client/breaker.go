@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Default number of consecutive failed RPCs that open a circuit breaker
+	defaultBreakerThreshold = 5
+	// Default for how long a circuit breaker stays open before letting an RPC through to test the database
+	defaultBreakerCooldown = 10 * time.Second
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	// The cooldown has passed and a single RPC may be let through to test whether the database has recovered
+	breakerHalfOpen
+)
+
+// Keeps a circuit breaker for each method, so that one method failing, such as a slow search timing out, doesn't
+// fail RPCs to the rest of the service
+type circuitBreakers struct {
+	threshold int
+	cooldown  time.Duration
+
+	lk       sync.Mutex
+	byMethod map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(threshold int, cooldown time.Duration) *circuitBreakers {
+	if threshold == 0 {
+		threshold = defaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultBreakerCooldown
+	}
+
+	return &circuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		byMethod:  make(map[string]*circuitBreaker),
+	}
+}
+
+// Returns the breaker for a method, creating it on first use
+func (bs *circuitBreakers) get(method string) *circuitBreaker {
+	bs.lk.Lock()
+	defer bs.lk.Unlock()
+
+	b, ok := bs.byMethod[method]
+	if !ok {
+		b = &circuitBreaker{
+			method:    method,
+			threshold: bs.threshold,
+			cooldown:  bs.cooldown,
+		}
+		bs.byMethod[method] = b
+	}
+	return b
+}
+
+func (bs *circuitBreakers) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	b := bs.get(method)
+
+	probe, ok := b.allow()
+	if !ok {
+		return status.Error(codes.Unavailable, "database circuit breaker is open")
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.record(ctx, err, probe)
+	return err
+}
+
+// Fails RPCs to a method immediately while the database service can't serve it, instead of every caller waiting out
+// its deadline. The breaker opens after a run of RPCs fail with Unavailable or DeadlineExceeded, which are the
+// failures that mean the service couldn't serve them at all, and closes again once an RPC succeeds after the cooldown.
+type circuitBreaker struct {
+	method    string
+	threshold int
+	cooldown  time.Duration
+
+	lk       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	// Whether the RPC testing the database while half-open is still in flight
+	probing bool
+}
+
+// Returns whether an RPC may be made, and whether it's the RPC testing the database
+func (b *circuitBreaker) allow() (probe bool, ok bool) {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false, false
+		}
+		b.state = breakerHalfOpen
+		fallthrough
+	case breakerHalfOpen:
+		// Only the RPC testing the database is let through until it finishes
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, true
+	default:
+		return false, true
+	}
+}
+
+// Records the result of an RPC that was allowed
+func (b *circuitBreaker) record(ctx context.Context, err error, probe bool) {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+
+	// RPCs that failed because the caller gave up or ran out of its own time say nothing about the database, so they
+	// leave the breaker as it was. A test RPC that ends this way lets another one through.
+	if ctx.Err() != nil || status.Code(err) == codes.Canceled {
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		b.failures++
+		if probe || (b.state == breakerClosed && b.failures >= b.threshold) {
+			if b.state != breakerOpen {
+				breakerOpens.WithLabelValues(b.method).Inc()
+			}
+			b.state = breakerOpen
+			b.openedAt = time.Now()
+			breakerIsOpen.WithLabelValues(b.method).Set(1)
+		}
+	default:
+		b.failures = 0
+		b.state = breakerClosed
+		breakerIsOpen.WithLabelValues(b.method).Set(0)
+	}
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errUnavailable      = status.Error(codes.Unavailable, "unavailable")
+	errDeadlineExceeded = status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	errNotFound         = status.Error(codes.NotFound, "not found")
+)
+
+func newTestBreaker(threshold int) *circuitBreaker {
+	return newCircuitBreakers(threshold, time.Minute).get("/vyletdatabase.PostService/GetPosts")
+}
+
+// Makes an allowed RPC that ends with the given error, failing the test if the breaker doesn't allow it
+func call(t *testing.T, b *circuitBreaker, ctx context.Context, err error) {
+	t.Helper()
+
+	probe, ok := b.allow()
+	if !ok {
+		t.Fatalf("RPC rejected in state %d", b.state)
+	}
+	b.record(ctx, err, probe)
+}
+
+// Moves an open breaker past its cooldown
+func expireCooldown(b *circuitBreaker) {
+	b.openedAt = time.Now().Add(-b.cooldown)
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBreaker(3)
+
+	call(t, b, ctx, errUnavailable)
+	call(t, b, ctx, errDeadlineExceeded)
+	// A success resets the run of failures
+	call(t, b, ctx, nil)
+	call(t, b, ctx, errUnavailable)
+	call(t, b, ctx, errUnavailable)
+	if b.state != breakerClosed {
+		t.Fatalf("breaker opened after two consecutive failures")
+	}
+
+	// Errors from the service itself mean it's serving, so they don't count as failures either
+	call(t, b, ctx, errNotFound)
+	call(t, b, ctx, errUnavailable)
+	call(t, b, ctx, errUnavailable)
+	if b.state != breakerClosed {
+		t.Fatalf("breaker opened after an application error reset the failures")
+	}
+
+	call(t, b, ctx, errUnavailable)
+	if b.state != breakerOpen {
+		t.Fatalf("got state %d after three consecutive failures, want open", b.state)
+	}
+	if _, ok := b.allow(); ok {
+		t.Fatalf("open breaker allowed an RPC before its cooldown")
+	}
+}
+
+func TestBreakerLetsOneProbeThroughAfterCooldown(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBreaker(1)
+
+	call(t, b, ctx, errUnavailable)
+	expireCooldown(b)
+
+	probe, ok := b.allow()
+	if !ok || !probe {
+		t.Fatalf("got probe %t, ok %t after the cooldown, want a probe", probe, ok)
+	}
+	if b.state != breakerHalfOpen {
+		t.Fatalf("got state %d, want half-open", b.state)
+	}
+	if _, ok := b.allow(); ok {
+		t.Fatalf("half-open breaker allowed a second RPC while the probe was in flight")
+	}
+
+	b.record(ctx, nil, probe)
+	if b.state != breakerClosed {
+		t.Fatalf("got state %d after a successful probe, want closed", b.state)
+	}
+	if probe, ok := b.allow(); !ok || probe {
+		t.Fatalf("got probe %t, ok %t once closed, want a normal RPC", probe, ok)
+	}
+}
+
+func TestBreakerReopensWhenProbeFails(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBreaker(3)
+
+	for range 3 {
+		call(t, b, ctx, errUnavailable)
+	}
+	expireCooldown(b)
+
+	// A failed probe reopens the breaker straight away, without waiting for the threshold again
+	call(t, b, ctx, errUnavailable)
+	if b.state != breakerOpen {
+		t.Fatalf("got state %d after a failed probe, want open", b.state)
+	}
+	if time.Since(b.openedAt) >= b.cooldown {
+		t.Fatalf("failed probe didn't restart the cooldown")
+	}
+	if _, ok := b.allow(); ok {
+		t.Fatalf("reopened breaker allowed an RPC before its cooldown")
+	}
+}
+
+func TestBreakerIgnoresCallerCancellation(t *testing.T) {
+	b := newTestBreaker(1)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	// RPCs that end because the caller gave up don't count as failures
+	call(t, b, context.Background(), status.Error(codes.Canceled, "canceled"))
+	call(t, b, cancelled, errUnavailable)
+	call(t, b, expired, errDeadlineExceeded)
+	if b.state != breakerClosed {
+		t.Fatalf("got state %d after cancelled RPCs, want closed", b.state)
+	}
+
+	call(t, b, context.Background(), errUnavailable)
+	expireCooldown(b)
+
+	// A probe that the caller gives up on leaves the breaker half-open and lets another probe through
+	probe, ok := b.allow()
+	if !ok || !probe {
+		t.Fatalf("got probe %t, ok %t after the cooldown, want a probe", probe, ok)
+	}
+	b.record(expired, errDeadlineExceeded, probe)
+	if b.state != breakerHalfOpen {
+		t.Fatalf("got state %d after an expired probe, want half-open", b.state)
+	}
+
+	probe, ok = b.allow()
+	if !ok || !probe {
+		t.Fatalf("got probe %t, ok %t after an expired probe, want another probe", probe, ok)
+	}
+}
+
+func TestBreakersAreKeptPerMethod(t *testing.T) {
+	bs := newCircuitBreakers(1, time.Minute)
+
+	failing := "/vyletdatabase.SearchService/SearchPosts"
+	healthy := "/vyletdatabase.PostService/GetPosts"
+
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if method == failing {
+			return errDeadlineExceeded
+		}
+		return nil
+	}
+
+	ctx := context.Background()
+	if err := bs.unaryInterceptor(ctx, failing, nil, nil, nil, invoker); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("got %v from the failing method, want its own error", err)
+	}
+	if err := bs.unaryInterceptor(ctx, failing, nil, nil, nil, invoker); status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v from the failing method once its breaker opened, want Unavailable", err)
+	}
+	if err := bs.unaryInterceptor(ctx, healthy, nil, nil, nil, invoker); err != nil {
+		t.Fatalf("got %v from another method, want it unaffected", err)
+	}
+}
@@ -2,11 +2,18 @@ package client
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
+	"time"
 
 	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type Client struct {
@@ -15,19 +22,82 @@ type Client struct {
 	Post    vyletdatabase.PostServiceClient
 	Like    vyletdatabase.LikeServiceClient
 	BlobRef vyletdatabase.BlobRefServiceClient
+	Tag     vyletdatabase.TagServiceClient
+
+	Notification vyletdatabase.NotificationServiceClient
+	Search       vyletdatabase.SearchServiceClient
+	Follow       vyletdatabase.FollowServiceClient
 }
 
 type Args struct {
+	// Address of the database service. Every replica the address resolves to is used, or several replicas can be
+	// given separated by commas.
 	Addr string
+
+	Options
+}
+
+type Options struct {
+	TLS TLSArgs
+
+	// Deadline for RPCs made without a shorter one. Defaults to 10 seconds.
+	Timeout time.Duration
+	// Deadlines for individual RPCs that override Timeout, keyed like PostService/GetPosts
+	MethodTimeouts map[string]time.Duration
+	// Attempts made for reads that fail because a replica is unavailable, including the first. Defaults to 3, and 1
+	// disables retries. Writes are never retried.
+	MaxReadAttempts int
+
+	// Consecutive RPCs to a method that must fail with Unavailable or DeadlineExceeded to open its circuit breaker.
+	// RPCs whose own context was cancelled or ran out don't count. Defaults to 5, and a negative value disables the
+	// breakers.
+	BreakerThreshold int
+	// How long a circuit breaker stays open before an RPC is let through to test the database. Defaults to 10
+	// seconds.
+	BreakerCooldown time.Duration
+}
+
+type TLSArgs struct {
+	// Connect without TLS, for local development against a server that is also running without it
+	Insecure bool
+	// PEM CA bundle that the server's certificate is verified against. If empty, the system roots are used.
+	CAFile string
+	// PEM certificate and key presented to servers that require mutual TLS. The certificate's common name identifies
+	// the client to the server's authorization policy.
+	CertFile string
+	KeyFile  string
+	// Name the server's certificate is verified against. Defaults to the host in Addr.
+	ServerName string
+	// Skip verifying the server's certificate, which is only suitable for a server using a generated self-signed
+	// certificate
+	InsecureSkipVerify bool
 }
 
 func New(args *Args) (*Client, error) {
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: true,
+	creds, err := newClientCredentials(&args.TLS)
+	if err != nil {
+		return nil, err
 	}
-	creds := credentials.NewTLS(tlsConfig)
 
-	conn, err := grpc.NewClient(args.Addr, grpc.WithTransportCredentials(creds))
+	serviceConfig, err := buildServiceConfig(&args.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptors := []grpc.UnaryClientInterceptor{metricsUnaryInterceptor}
+	if args.BreakerThreshold >= 0 {
+		breakers := newCircuitBreakers(args.BreakerThreshold, args.BreakerCooldown)
+		interceptors = append(interceptors, breakers.unaryInterceptor)
+	}
+
+	target, opts := dialTarget(args.Addr)
+	opts = append(opts,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+	)
+
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect: %w", err)
 	}
@@ -36,6 +106,10 @@ func New(args *Args) (*Client, error) {
 	postClient := vyletdatabase.NewPostServiceClient(conn)
 	likeClient := vyletdatabase.NewLikeServiceClient(conn)
 	blobRefClient := vyletdatabase.NewBlobRefServiceClient(conn)
+	tagClient := vyletdatabase.NewTagServiceClient(conn)
+	notificationClient := vyletdatabase.NewNotificationServiceClient(conn)
+	searchClient := vyletdatabase.NewSearchServiceClient(conn)
+	followClient := vyletdatabase.NewFollowServiceClient(conn)
 
 	client := Client{
 		client:  conn,
@@ -43,15 +117,89 @@ func New(args *Args) (*Client, error) {
 		Post:    postClient,
 		Like:    likeClient,
 		BlobRef: blobRefClient,
+		Tag:     tagClient,
+
+		Notification: notificationClient,
+		Search:       searchClient,
+		Follow:       followClient,
 	}
 
 	return &client, nil
 }
 
+func newClientCredentials(args *TLSArgs) (credentials.TransportCredentials, error) {
+	if args.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         args.ServerName,
+		InsecureSkipVerify: args.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS13,
+	}
+
+	if args.CAFile != "" {
+		b, err := os.ReadFile(args.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in %s", args.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if args.CertFile != "" || args.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(args.CertFile, args.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 func (c *Client) Close() error {
 	return c.client.Close()
 }
 
-func IsNotFoundError(errStr *string) bool {
-	return errStr != nil && *errStr == "not found"
+// Returns whether the database service reported that the requested row doesn't exist
+func IsNotFoundError(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// Returns whether the database service rejected a request's cursor
+func IsInvalidCursorError(err error) bool {
+	return hasErrorReason(err, vyletdatabase.ErrorReason_ERROR_REASON_INVALID_CURSOR)
+}
+
+// Returns whether the request was otherwise invalid, including an invalid cursor
+func IsInvalidArgumentError(err error) bool {
+	return status.Code(err) == codes.InvalidArgument
+}
+
+// Returns whether the request failed because the database service or Cassandra couldn't serve it right now, in which
+// case it may succeed if retried
+func IsUnavailableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasErrorReason(err error, reason vyletdatabase.ErrorReason) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == reason.String() {
+			return true
+		}
+	}
+	return false
 }
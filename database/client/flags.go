@@ -0,0 +1,109 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Returns the CLI flags for the database client's options, for services that use a client. Each flag is read from
+// the service's own environment variable first, e.g. VYLET_API_DATABASE_CA_FILE, and the shared ones fall back to
+// VYLET_DATABASE_*, so that one CA and the insecure mode can be configured for every service at once. Client
+// certificates identify a single service, so they have no shared variable.
+func Flags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "database-insecure",
+			Usage:   "connect to the database service without TLS, for local development only",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_INSECURE", envPrefix), "VYLET_DATABASE_INSECURE"},
+		},
+		&cli.StringFlag{
+			Name:    "database-ca-file",
+			Usage:   "PEM CA bundle used to verify the database service's certificate. the system roots are used if unset",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_CA_FILE", envPrefix), "VYLET_DATABASE_CA_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "database-cert-file",
+			Usage:   "PEM client certificate presented to the database service for mutual TLS",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_CERT_FILE", envPrefix)},
+		},
+		&cli.StringFlag{
+			Name:    "database-key-file",
+			Usage:   "PEM private key for the client certificate",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_KEY_FILE", envPrefix)},
+		},
+		&cli.StringFlag{
+			Name:    "database-server-name",
+			Usage:   "name the database service's certificate is verified against, if it differs from the host",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_SERVER_NAME", envPrefix), "VYLET_DATABASE_SERVER_NAME"},
+		},
+		&cli.BoolFlag{
+			Name:    "database-tls-insecure-skip-verify",
+			Usage:   "don't verify the database service's certificate, for a server using a generated self-signed certificate",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_TLS_INSECURE_SKIP_VERIFY", envPrefix), "VYLET_DATABASE_TLS_INSECURE_SKIP_VERIFY"},
+		},
+		&cli.DurationFlag{
+			Name:    "database-timeout",
+			Usage:   "deadline for database requests made without a shorter one",
+			Value:   defaultTimeout,
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_TIMEOUT", envPrefix), "VYLET_DATABASE_TIMEOUT"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "database-method-timeouts",
+			Usage:   "deadlines for individual database requests, e.g. PostService/GetPosts=2s",
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_METHOD_TIMEOUTS", envPrefix)},
+		},
+		&cli.IntFlag{
+			Name:    "database-max-read-attempts",
+			Usage:   "attempts made for database reads that fail because a replica is unavailable. 1 disables retries",
+			Value:   defaultMaxReadAttempts,
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_MAX_READ_ATTEMPTS", envPrefix), "VYLET_DATABASE_MAX_READ_ATTEMPTS"},
+		},
+		&cli.IntFlag{
+			Name:    "database-breaker-threshold",
+			Usage:   "consecutive unavailable database requests to a method that open its circuit breaker. negative disables them",
+			Value:   defaultBreakerThreshold,
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_BREAKER_THRESHOLD", envPrefix), "VYLET_DATABASE_BREAKER_THRESHOLD"},
+		},
+		&cli.DurationFlag{
+			Name:    "database-breaker-cooldown",
+			Usage:   "how long a circuit breaker stays open before testing the database again",
+			Value:   defaultBreakerCooldown,
+			EnvVars: []string{fmt.Sprintf("%s_DATABASE_BREAKER_COOLDOWN", envPrefix), "VYLET_DATABASE_BREAKER_COOLDOWN"},
+		},
+	}
+}
+
+// Reads the flags returned by Flags
+func OptionsFromCLI(cmd *cli.Context) (Options, error) {
+	methodTimeouts := make(map[string]time.Duration)
+	for _, mt := range cmd.StringSlice("database-method-timeouts") {
+		method, timeout, ok := strings.Cut(mt, "=")
+		if !ok {
+			return Options{}, fmt.Errorf("invalid method timeout %q, expected Service/Method=duration", mt)
+		}
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid method timeout %q: %w", mt, err)
+		}
+		methodTimeouts[method] = d
+	}
+
+	return Options{
+		TLS: TLSArgs{
+			Insecure:           cmd.Bool("database-insecure"),
+			CAFile:             cmd.String("database-ca-file"),
+			CertFile:           cmd.String("database-cert-file"),
+			KeyFile:            cmd.String("database-key-file"),
+			ServerName:         cmd.String("database-server-name"),
+			InsecureSkipVerify: cmd.Bool("database-tls-insecure-skip-verify"),
+		},
+		Timeout:          cmd.Duration("database-timeout"),
+		MethodTimeouts:   methodTimeouts,
+		MaxReadAttempts:  cmd.Int("database-max-read-attempts"),
+		BreakerThreshold: cmd.Int("database-breaker-threshold"),
+		BreakerCooldown:  cmd.Duration("database-breaker-cooldown"),
+	}, nil
+}
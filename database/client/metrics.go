@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	namespace = "database_client"
+)
+
+var (
+	// RPCs made to the database service, by method and status code
+	requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "requests_total",
+		Help:      "Total number of RPCs made to the database service",
+	}, []string{"method", "code"})
+
+	// Duration of RPCs made to the database service, including retries, by method and status code
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of RPCs made to the database service",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"method", "code"})
+
+	// Number of times a method's circuit breaker has opened, by method
+	breakerOpens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_opens_total",
+		Help:      "Total number of times a method's circuit breaker has opened",
+	}, []string{"method"})
+
+	// Whether a method's circuit breaker is currently open, by method
+	breakerIsOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_open",
+		Help:      "Whether a method's circuit breaker is open (1) or not (0)",
+	}, []string{"method"})
+)
+
+func metricsUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	code := status.Code(err).String()
+	requests.WithLabelValues(method, code).Inc()
+	requestDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+
+	return err
+}
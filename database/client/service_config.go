@@ -0,0 +1,142 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// Default deadline for RPCs made without a shorter one
+	defaultTimeout = 10 * time.Second
+	// Default number of attempts for reads that fail because a replica is unavailable, including the first
+	defaultMaxReadAttempts = 3
+)
+
+// The subset of the gRPC service config used by the client, see
+// https://github.com/grpc/grpc/blob/master/doc/service_config.md
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	HealthCheckConfig   healthCheckConfig     `json:"healthCheckConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig"`
+}
+
+type healthCheckConfig struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// Builds the service config for the database services. RPCs are spread round robin across every replica, skipping
+// replicas whose health service reports that they aren't serving, such as ones that have lost Cassandra or are
+// draining for shutdown. Every RPC gets a deadline, and reads are retried with backoff when a replica is
+// unavailable. Reads are the RPCs marked with idempotency_level = NO_SIDE_EFFECTS, which are always safe to retry,
+// unlike writes such as CreateLike that update counters.
+func buildServiceConfig(opts *Options) (string, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	maxReadAttempts := opts.MaxReadAttempts
+	if maxReadAttempts <= 0 {
+		maxReadAttempts = defaultMaxReadAttempts
+	}
+
+	cfg := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+		HealthCheckConfig:   healthCheckConfig{ServiceName: ""},
+	}
+
+	unknownTimeouts := make(map[string]struct{}, len(opts.MethodTimeouts))
+	for name := range opts.MethodTimeouts {
+		unknownTimeouts[name] = struct{}{}
+	}
+
+	protoregistry.GlobalFiles.RangeFilesByPackage("vyletdatabase", func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+
+				// Method timeouts are keyed like PostService/GetPosts
+				key := string(service.Name()) + "/" + string(method.Name())
+				methodTimeout := timeout
+				if t, ok := opts.MethodTimeouts[key]; ok {
+					methodTimeout = t
+					delete(unknownTimeouts, key)
+				}
+
+				mc := methodConfig{
+					Name: []methodName{{
+						Service: string(service.FullName()),
+						Method:  string(method.Name()),
+					}},
+					Timeout: formatDuration(methodTimeout),
+				}
+
+				if maxReadAttempts > 1 && hasNoSideEffects(method) {
+					mc.RetryPolicy = &retryPolicy{
+						MaxAttempts:          maxReadAttempts,
+						InitialBackoff:       "0.1s",
+						MaxBackoff:           "1s",
+						BackoffMultiplier:    2,
+						RetryableStatusCodes: []string{"UNAVAILABLE"},
+					}
+				}
+
+				cfg.MethodConfig = append(cfg.MethodConfig, mc)
+			}
+		}
+		return true
+	})
+
+	if len(unknownTimeouts) > 0 {
+		names := make([]string, 0, len(unknownTimeouts))
+		for name := range unknownTimeouts {
+			names = append(names, name)
+		}
+		return "", fmt.Errorf("timeouts given for unknown methods: %s", strings.Join(names, ", "))
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal service config: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func hasNoSideEffects(method protoreflect.MethodDescriptor) bool {
+	opts, ok := method.Options().(*descriptorpb.MethodOptions)
+	return ok && opts.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// Formats a duration the way the service config expects, as decimal seconds with an "s" suffix
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
@@ -0,0 +1,76 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"text/template"
+)
+
+const (
+	// Directory in the migrations holding each environment's variables, as a JSON object of strings
+	environmentsDir = "environments"
+	// Environment whose variables every other environment inherits
+	defaultEnvironment = "default"
+	// Variable that always holds the keyspace being migrated
+	keyspaceVar = "keyspace"
+	// Variable used as the replication of a created keyspace, when set
+	ReplicationVar = "replication"
+)
+
+// Returns the variables of an environment, which are those of environments/default.json overridden by those of
+// environments/<name>.json. An empty name only uses the defaults.
+func LoadVars(fsys fs.FS, environment string) (map[string]string, error) {
+	vars := make(map[string]string)
+
+	if err := readVars(fsys, defaultEnvironment, vars); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if environment != "" && environment != defaultEnvironment {
+		if err := readVars(fsys, environment, vars); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("unknown environment %q", environment)
+			}
+			return nil, err
+		}
+	}
+
+	return vars, nil
+}
+
+func readVars(fsys fs.FS, environment string, vars map[string]string) error {
+	b, err := fs.ReadFile(fsys, path.Join(environmentsDir, environment+".json"))
+	if err != nil {
+		return err
+	}
+
+	var envVars map[string]string
+	if err := json.Unmarshal(b, &envVars); err != nil {
+		return fmt.Errorf("failed to parse variables of environment %s: %w", environment, err)
+	}
+	for k, v := range envVars {
+		vars[k] = v
+	}
+
+	return nil
+}
+
+// Renders a migration file as a text/template with the given variables, e.g. {{.keyspace}}. Referencing a variable
+// that isn't set is an error.
+func render(name string, cql string, vars map[string]string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(cql)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
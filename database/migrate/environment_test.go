@@ -0,0 +1,129 @@
+package migrate
+
+import (
+	"maps"
+	"testing"
+	"testing/fstest"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{
+		"keyspace":    "vylet",
+		"replication": "{'class': 'SimpleStrategy', 'replication_factor': 1}",
+	}
+
+	tests := []struct {
+		name    string
+		cql     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no variables",
+			cql:  "CREATE TABLE t (k INT PRIMARY KEY);",
+			want: "CREATE TABLE t (k INT PRIMARY KEY);",
+		},
+		{
+			name: "variables",
+			cql:  "CREATE KEYSPACE {{.keyspace}} WITH replication = {{.replication}};",
+			want: "CREATE KEYSPACE vylet WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};",
+		},
+		{
+			name: "variable used twice",
+			cql:  "DROP TABLE {{.keyspace}}.a; DROP TABLE {{.keyspace}}.b;",
+			want: "DROP TABLE vylet.a; DROP TABLE vylet.b;",
+		},
+		{
+			name: "map literals are left alone",
+			cql:  "ALTER TABLE t WITH compaction = {'class': 'SizeTieredCompactionStrategy'};",
+			want: "ALTER TABLE t WITH compaction = {'class': 'SizeTieredCompactionStrategy'};",
+		},
+		{
+			name:    "missing variable",
+			cql:     "CREATE TABLE t (k INT PRIMARY KEY) WITH compaction = {{.compaction}};",
+			wantErr: true,
+		},
+		{
+			name:    "invalid template",
+			cql:     "CREATE TABLE {{.keyspace.t (k INT PRIMARY KEY);",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := render(tt.name, tt.cql, vars)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadVars(t *testing.T) {
+	fsys := fstest.MapFS{
+		"environments/default.json":    {Data: []byte(`{"replication": "default", "ttl": "0"}`)},
+		"environments/production.json": {Data: []byte(`{"replication": "production"}`)},
+		"environments/broken.json":     {Data: []byte(`{"replication": 3}`)},
+	}
+
+	tests := []struct {
+		name        string
+		environment string
+		want        map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "defaults",
+			environment: "",
+			want:        map[string]string{"replication": "default", "ttl": "0"},
+		},
+		{
+			name:        "default by name",
+			environment: "default",
+			want:        map[string]string{"replication": "default", "ttl": "0"},
+		},
+		{
+			name:        "environment overrides defaults",
+			environment: "production",
+			want:        map[string]string{"replication": "production", "ttl": "0"},
+		},
+		{
+			name:        "unknown environment",
+			environment: "staging",
+			wantErr:     true,
+		},
+		{
+			name:        "variables that aren't strings",
+			environment: "broken",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadVars(fsys, tt.environment)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/gocql/gocql"
+)
+
+// Replication used for new keyspaces when none is configured, which is only suitable for a single node
+const DefaultReplication = "{'class': 'SimpleStrategy', 'replication_factor': 1}"
+
+var keyspaceNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]{1,48}$`)
+
+// Creates the keyspace if it doesn't exist. The session must not be bound to a keyspace. Replication is a CQL map
+// literal, e.g. {'class': 'NetworkTopologyStrategy', 'dc1': 3}. An existing keyspace's replication is left alone.
+func CreateKeyspace(ctx context.Context, session *gocql.Session, keyspace string, replication string) error {
+	if !keyspaceNameRegex.MatchString(keyspace) {
+		return fmt.Errorf("invalid keyspace name %q", keyspace)
+	}
+
+	if err := session.Query(CreateKeyspaceStatement(keyspace, replication)).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to create keyspace: %w", err)
+	}
+
+	if err := session.AwaitSchemaAgreement(ctx); err != nil {
+		return fmt.Errorf("failed to wait for schema agreement: %w", err)
+	}
+
+	return nil
+}
+
+// Returns the statement that creates the keyspace if it doesn't exist, using the default replication when none is
+// given
+func CreateKeyspaceStatement(keyspace string, replication string) string {
+	if replication == "" {
+		replication = DefaultReplication
+	}
+	return fmt.Sprintf("CREATE KEYSPACE IF NOT EXISTS %s WITH replication = %s", keyspace, replication)
+}
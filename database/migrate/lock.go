@@ -0,0 +1,143 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	// Table holding the migration lock
+	lockTable = "schema_migration_lock"
+	// Row of the lock table that is locked
+	lockName = "migrations"
+	// How long the lock is held without being refreshed, so that a migrator that dies doesn't hold it forever
+	lockTTL = time.Minute
+	// How often the lock is refreshed while it's held
+	lockRefreshInterval = lockTTL / 4
+)
+
+// ErrLocked is returned when another migrator holds the migration lock
+var ErrLocked = errors.New("migration lock is held")
+
+func lockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return hostname + "/" + gocql.TimeUUID().String()
+}
+
+// Runs fn while holding the migration lock, so that replicas migrating on startup and the migrate tool never run
+// migrations at the same time. The lock is a lightweight transaction on a row that expires unless it's refreshed.
+// If the lock is lost, the context passed to fn is cancelled so that no further statements run.
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.dryRun {
+		return fn(ctx)
+	}
+
+	exists, err := m.tableExists(ctx, lockTable)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := m.createTable(ctx, lockTable, `
+			name text PRIMARY KEY,
+			owner text,
+			acquired_at timestamp
+		`); err != nil {
+			return err
+		}
+	}
+
+	acquiredAt := time.Now().UTC()
+	existing := make(map[string]any)
+	applied, err := m.session.Query(`
+		INSERT INTO `+m.table(lockTable)+`
+			(name, owner, acquired_at)
+		VALUES
+			(?, ?, ?)
+		IF NOT EXISTS
+		USING TTL ?
+	`, lockName, m.owner, acquiredAt, int(lockTTL.Seconds())).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	if !applied {
+		return fmt.Errorf("%w by %v since %v", ErrLocked, existing["owner"], existing["acquired_at"])
+	}
+
+	m.logger.Debug("acquired migration lock", "owner", m.owner)
+
+	lockCtx, cancel := context.WithCancel(ctx)
+	refreshDone := make(chan struct{})
+	go func() {
+		defer close(refreshDone)
+		m.refreshLock(lockCtx, cancel, acquiredAt)
+	}()
+
+	err = fn(lockCtx)
+
+	cancel()
+	<-refreshDone
+
+	// The lock is released even if the caller's context was cancelled, rather than left to expire
+	releaseCtx, releaseCancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer releaseCancel()
+	if _, releaseErr := m.session.Query(`
+		DELETE FROM `+m.table(lockTable)+`
+		WHERE name = ?
+		IF owner = ?
+	`, lockName, m.owner).WithContext(releaseCtx).MapScanCAS(make(map[string]any)); releaseErr != nil {
+		m.logger.Error("failed to release migration lock, it will expire on its own", "err", releaseErr)
+	}
+
+	return err
+}
+
+// Keeps the lock from expiring until the context is cancelled, cancelling it if the lock is lost
+func (m *Migrator) refreshLock(ctx context.Context, cancel context.CancelFunc, acquiredAt time.Time) {
+	ticker := time.NewTicker(lockRefreshInterval)
+	defer ticker.Stop()
+
+	refreshedAt := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		applied, err := m.session.Query(`
+			UPDATE `+m.table(lockTable)+`
+			USING TTL ?
+			SET owner = ?, acquired_at = ?
+			WHERE name = ?
+			IF owner = ?
+		`, int(lockTTL.Seconds()), m.owner, acquiredAt, lockName, m.owner).WithContext(ctx).MapScanCAS(make(map[string]any))
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// A failed refresh is retried until the lock may have expired
+			if time.Since(refreshedAt) >= lockTTL-lockRefreshInterval {
+				m.logger.Error("failed to refresh migration lock before it expired, stopping migrations", "err", err)
+				cancel()
+				return
+			}
+			m.logger.Warn("failed to refresh migration lock", "err", err)
+			continue
+		}
+		if !applied {
+			m.logger.Error("lost migration lock, stopping migrations")
+			cancel()
+			return
+		}
+		refreshedAt = time.Now()
+	}
+}
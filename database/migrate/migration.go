@@ -0,0 +1,124 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Migration is a single schema change, read from a <version>_<name>.up.cql file and the matching .down.cql file
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+	// Checksum of the rendered up file, which is recorded when the migration is applied so that later edits to the
+	// file or to the variables it uses can be detected. Set once the migration is rendered.
+	Checksum string
+
+	hasUp bool
+
+	upStatements   []string
+	downStatements []string
+}
+
+var migrationFileRegex = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.cql$`)
+
+// Reads every migration in the root of fsys, sorted by version. Files that aren't named like migrations are ignored.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := migrationFileRegex.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		if version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s: versions must be positive", entry.Name())
+		}
+
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{
+				Version: version,
+				Name:    match[2],
+			}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+
+		switch match[3] {
+		case "up":
+			m.Up = string(b)
+			m.hasUp = true
+		case "down":
+			m.Down = string(b)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if !m.hasUp {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Renders the migration's files with the given variables, splits them into statements and takes the checksum of the
+// rendered up file
+func (m *Migration) prepare(vars map[string]string) error {
+	name := fmt.Sprintf("%d_%s", m.Version, m.Name)
+
+	up, err := render(name+".up.cql", m.Up, vars)
+	if err != nil {
+		return err
+	}
+	m.Checksum = checksum([]byte(up))
+	if m.upStatements, err = splitStatements(up); err != nil {
+		return fmt.Errorf("failed to split %s.up.cql: %w", name, err)
+	}
+
+	down, err := render(name+".down.cql", m.Down, vars)
+	if err != nil {
+		return err
+	}
+	if m.downStatements, err = splitStatements(down); err != nil {
+		return fmt.Errorf("failed to split %s.down.cql: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,622 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	// Table that records every applied migration
+	historyTable = "schema_migration_history"
+	// Table that golang-migrate recorded the current version in, which is adopted the first time the history is
+	// created
+	legacyTable = "schema_migrations"
+)
+
+// ErrDirty is returned when a migration failed part way through, leaving the schema in an unknown state. The schema
+// must be fixed by hand and the version forced before migrating again.
+var ErrDirty = errors.New("schema is dirty")
+
+// Applies and rolls back migrations, recording each applied migration in the keyspace along with when it was applied
+// and a checksum of its up file
+type Migrator struct {
+	logger     *slog.Logger
+	session    *gocql.Session
+	keyspace   string
+	migrations []*Migration
+	dryRun     bool
+	out        io.Writer
+	// Identifies this migrator when it holds the migration lock
+	owner string
+}
+
+type Args struct {
+	Logger *slog.Logger
+
+	// Session connected to the keyspace being migrated. In a dry run the session doesn't need to be bound to the
+	// keyspace, which doesn't need to exist yet.
+	Session  *gocql.Session
+	Keyspace string
+	// Directory containing the migration files, and the environments directory with their variables
+	Migrations fs.FS
+	// Environment whose variables are rendered into the migrations. Empty uses the default variables.
+	Environment string
+	// Variables that override the environment's
+	Vars map[string]string
+
+	// Print the statements that would run to Out rather than running them, without changing the migration history
+	DryRun bool
+	Out    io.Writer
+}
+
+func New(args *Args) (*Migrator, error) {
+	if args.Logger == nil {
+		args.Logger = slog.Default()
+	}
+
+	if args.Out == nil {
+		args.Out = os.Stdout
+	}
+	if !keyspaceNameRegex.MatchString(args.Keyspace) {
+		return nil, fmt.Errorf("invalid keyspace name %q", args.Keyspace)
+	}
+
+	migrations, err := Load(args.Migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := LoadVars(args.Migrations, args.Environment)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range args.Vars {
+		vars[k] = v
+	}
+	vars[keyspaceVar] = args.Keyspace
+
+	// Every migration is rendered up front so that a broken template fails before anything runs
+	for _, mig := range migrations {
+		if err := mig.prepare(vars); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Migrator{
+		logger:     args.Logger.With("component", "migrator"),
+		session:    args.Session,
+		keyspace:   args.Keyspace,
+		migrations: migrations,
+		dryRun:     args.DryRun,
+		out:        args.Out,
+		owner:      lockOwner(),
+	}, nil
+}
+
+// The state of a migration, whether or not it has been applied
+type Status struct {
+	Version int64
+	Name    string
+
+	Applied   bool
+	AppliedAt time.Time
+	// Whether the migration failed part way through
+	Dirty bool
+	// Whether the up file was edited after the migration was applied
+	Modified bool
+	// Whether the migration was applied but its files no longer exist
+	Missing bool
+}
+
+type appliedMigration struct {
+	version   int64
+	name      string
+	checksum  string
+	dirty     bool
+	appliedAt time.Time
+}
+
+// Returns the status of every known or applied migration, sorted by version
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.history(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		st := Status{
+			Version: mig.Version,
+			Name:    mig.Name,
+		}
+		if a, ok := applied[mig.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = a.appliedAt
+			st.Dirty = a.dirty
+			st.Modified = a.checksum != mig.Checksum
+		}
+		statuses = append(statuses, st)
+	}
+
+	for _, a := range applied {
+		if m.find(a.version) != nil {
+			continue
+		}
+		statuses = append(statuses, Status{
+			Version:   a.version,
+			Name:      a.name,
+			Applied:   true,
+			AppliedAt: a.appliedAt,
+			Dirty:     a.dirty,
+			Missing:   true,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Returns whether every migration has been applied and none are dirty. Applied migrations that aren't known, such
+// as ones added by a newer release, are ignored so that older replicas stay up to date during a rollout.
+func (m *Migrator) UpToDate(ctx context.Context) (bool, error) {
+	applied, err := m.history(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	if checkClean(applied) != nil {
+		return false, nil
+	}
+
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Applies every pending migration in order
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.checkedHistory(ctx)
+		if err != nil {
+			return err
+		}
+
+		return m.migrate(ctx, applied, m.latestVersion())
+	})
+}
+
+// Rolls back the most recently applied migration
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, m.down)
+}
+
+func (m *Migrator) down(ctx context.Context) error {
+	applied, err := m.history(ctx, true)
+	if err != nil {
+		return err
+	}
+	if err := checkClean(applied); err != nil {
+		return err
+	}
+
+	var latest int64
+	for version := range applied {
+		latest = max(latest, version)
+	}
+	if latest == 0 {
+		m.logger.Info("no migrations to roll back")
+		return nil
+	}
+
+	mig := m.find(latest)
+	if mig == nil {
+		return fmt.Errorf("cannot roll back migration %d: its files no longer exist", latest)
+	}
+
+	return m.rollback(ctx, mig)
+}
+
+// Applies or rolls back migrations until every migration up to and including version is applied and none after it
+// are. Version 0 rolls back every migration.
+func (m *Migrator) Goto(ctx context.Context, version int64) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+
+	return m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.checkedHistory(ctx)
+		if err != nil {
+			return err
+		}
+
+		return m.migrate(ctx, applied, version)
+	})
+}
+
+// Records every migration up to and including version as applied and every migration after it as not applied,
+// without running any of them. This is used to recover from a dirty schema once it has been fixed by hand, and also
+// accepts the current checksums of migrations that were edited after they were applied.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+
+	return m.withLock(ctx, func(ctx context.Context) error {
+		return m.force(ctx, version)
+	})
+}
+
+func (m *Migrator) force(ctx context.Context, version int64) error {
+	applied, err := m.history(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			continue
+		}
+		appliedAt := now
+		if a, ok := applied[mig.Version]; ok {
+			if !a.dirty && a.checksum == mig.Checksum {
+				continue
+			}
+			appliedAt = a.appliedAt
+		}
+		if err := m.record(ctx, mig, false, appliedAt); err != nil {
+			return err
+		}
+	}
+
+	for v := range applied {
+		if v <= version {
+			continue
+		}
+		if err := m.forget(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	m.logger.Info("forced schema version", "version", version)
+
+	return nil
+}
+
+// Returns the version the schema is at once every migration has been applied
+func (m *Migrator) latestVersion() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig
+		}
+	}
+	return nil
+}
+
+// Rolls back applied migrations after version, newest first, then applies pending migrations up to and including
+// it, oldest first
+func (m *Migrator) migrate(ctx context.Context, applied map[int64]*appliedMigration, version int64) error {
+	var count int
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if _, ok := applied[mig.Version]; !ok || mig.Version <= version {
+			continue
+		}
+		if err := m.rollback(ctx, mig); err != nil {
+			return err
+		}
+		count++
+	}
+
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; ok || mig.Version > version {
+			continue
+		}
+		if err := m.apply(ctx, mig); err != nil {
+			return err
+		}
+		count++
+	}
+
+	if count == 0 {
+		m.logger.Info("schema is up to date", "version", version)
+	} else {
+		m.logger.Info("migrations complete", "version", version, "count", count)
+	}
+
+	return nil
+}
+
+func (m *Migrator) apply(ctx context.Context, mig *Migration) error {
+	logger := m.logger.With("version", mig.Version, "name", mig.Name)
+	logger.Info("applying migration")
+
+	// The migration is recorded as dirty first so that a failure part way through is noticed
+	if err := m.record(ctx, mig, true, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	if err := m.exec(ctx, fmt.Sprintf("%d_%s up", mig.Version, mig.Name), mig.upStatements); err != nil {
+		return fmt.Errorf("failed to apply migration %d_%s, the schema is now dirty: %w", mig.Version, mig.Name, err)
+	}
+
+	return m.record(ctx, mig, false, time.Now().UTC())
+}
+
+func (m *Migrator) rollback(ctx context.Context, mig *Migration) error {
+	logger := m.logger.With("version", mig.Version, "name", mig.Name)
+	logger.Info("rolling back migration")
+
+	if len(mig.downStatements) == 0 {
+		return fmt.Errorf("migration %d_%s cannot be rolled back: it has no down statements", mig.Version, mig.Name)
+	}
+
+	if !m.dryRun {
+		if err := m.session.Query(`
+			UPDATE `+m.table(historyTable)+`
+			SET dirty = true
+			WHERE version = ?
+		`, mig.Version).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to mark migration %d dirty: %w", mig.Version, err)
+		}
+	}
+
+	if err := m.exec(ctx, fmt.Sprintf("%d_%s down", mig.Version, mig.Name), mig.downStatements); err != nil {
+		return fmt.Errorf("failed to roll back migration %d_%s, the schema is now dirty: %w", mig.Version, mig.Name, err)
+	}
+
+	return m.forget(ctx, mig.Version)
+}
+
+// Runs the statements of a migration file, waiting for every node to agree on the schema after each one so that the
+// next statement doesn't race a schema change that hasn't reached its coordinator yet. A dry run prints them instead.
+func (m *Migrator) exec(ctx context.Context, name string, statements []string) error {
+	if m.dryRun {
+		fmt.Fprintf(m.out, "-- %s\n", name)
+		for _, stmt := range statements {
+			fmt.Fprintf(m.out, "%s;\n\n", stmt)
+		}
+		return nil
+	}
+
+	for _, stmt := range statements {
+		m.logger.Debug("executing statement", "cql", stmt)
+		if err := m.session.Query(stmt).WithContext(ctx).Exec(); err != nil {
+			return err
+		}
+		if err := m.session.AwaitSchemaAgreement(ctx); err != nil {
+			return fmt.Errorf("failed to wait for schema agreement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) record(ctx context.Context, mig *Migration, dirty bool, appliedAt time.Time) error {
+	if m.dryRun {
+		return nil
+	}
+
+	if err := m.session.Query(`
+		INSERT INTO `+m.table(historyTable)+`
+			(version, name, checksum, dirty, applied_at)
+		VALUES
+			(?, ?, ?, ?, ?)
+	`, mig.Version, mig.Name, mig.Checksum, dirty, appliedAt).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", mig.Version, err)
+	}
+	return nil
+}
+
+func (m *Migrator) forget(ctx context.Context, version int64) error {
+	if m.dryRun {
+		return nil
+	}
+
+	if err := m.session.Query(`
+		DELETE FROM `+m.table(historyTable)+`
+		WHERE version = ?
+	`, version).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to remove migration %d from history: %w", version, err)
+	}
+	return nil
+}
+
+// Returns the applied migrations after checking that the schema is clean and that none of them were edited or
+// removed after they were applied
+func (m *Migrator) checkedHistory(ctx context.Context) (map[int64]*appliedMigration, error) {
+	applied, err := m.history(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkClean(applied); err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, a := range applied {
+		mig := m.find(a.version)
+		switch {
+		case mig == nil:
+			problems = append(problems, fmt.Sprintf("%d_%s was applied but its files no longer exist", a.version, a.name))
+		case mig.Checksum != a.checksum:
+			problems = append(problems, fmt.Sprintf("%d_%s was edited after it was applied", a.version, a.name))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("applied migrations do not match their files, restore them or force the version to accept them: %s", strings.Join(problems, "; "))
+	}
+
+	return applied, nil
+}
+
+func checkClean(applied map[int64]*appliedMigration) error {
+	for _, a := range applied {
+		if a.dirty {
+			return fmt.Errorf("%w: migration %d_%s did not finish, fix the schema by hand then force the version", ErrDirty, a.version, a.name)
+		}
+	}
+	return nil
+}
+
+// Returns the keyspace qualified name of a table
+func (m *Migrator) table(name string) string {
+	return m.keyspace + "." + name
+}
+
+func (m *Migrator) tableExists(ctx context.Context, name string) (bool, error) {
+	var tableName string
+	if err := m.session.Query(`
+		SELECT table_name
+		FROM system_schema.tables
+		WHERE keyspace_name = ? AND table_name = ?
+	`, m.keyspace, name).WithContext(ctx).Scan(&tableName); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up table %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// Returns every applied migration by version. When create is set the history table is created if needed, and a
+// legacy version is recorded in it when it's created, except in a dry run. Otherwise the schema isn't changed.
+func (m *Migrator) history(ctx context.Context, create bool) (map[int64]*appliedMigration, error) {
+	create = create && !m.dryRun
+
+	exists, err := m.tableExists(ctx, historyTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if !create {
+			return m.adoptLegacyVersion(ctx, false)
+		}
+		if err := m.createTable(ctx, historyTable, `
+			version bigint PRIMARY KEY,
+			name text,
+			checksum text,
+			dirty boolean,
+			applied_at timestamp
+		`); err != nil {
+			return nil, err
+		}
+		// The legacy version is only adopted when the history is created. Once the history exists it's the only
+		// record of what's applied, even if every migration has since been rolled back.
+		return m.adoptLegacyVersion(ctx, true)
+	}
+
+	iter := m.session.Query(`
+		SELECT version, name, checksum, dirty, applied_at
+		FROM ` + m.table(historyTable) + `
+	`).WithContext(ctx).Iter()
+
+	applied := make(map[int64]*appliedMigration)
+	for {
+		a := &appliedMigration{}
+		if !iter.Scan(&a.version, &a.name, &a.checksum, &a.dirty, &a.appliedAt) {
+			break
+		}
+		applied[a.version] = a
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate migration history: %w", err)
+	}
+
+	return applied, nil
+}
+
+// Creates one of the migrator's own tables
+func (m *Migrator) createTable(ctx context.Context, name string, columns string) error {
+	if err := m.session.Query(`
+		CREATE TABLE IF NOT EXISTS ` + m.table(name) + ` (` + columns + `)
+	`).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to create table %s: %w", name, err)
+	}
+	if err := m.session.AwaitSchemaAgreement(ctx); err != nil {
+		return fmt.Errorf("failed to wait for schema agreement: %w", err)
+	}
+	return nil
+}
+
+// Keyspaces migrated with golang-migrate only recorded their current version. The first time the history is created,
+// every migration up to that version is recorded as applied, with the current checksums. Without record, the
+// migrations are only returned as applied.
+func (m *Migrator) adoptLegacyVersion(ctx context.Context, record bool) (map[int64]*appliedMigration, error) {
+	applied := make(map[int64]*appliedMigration)
+
+	exists, err := m.tableExists(ctx, legacyTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return applied, nil
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	if err := m.session.Query(`
+		SELECT version, dirty
+		FROM `+m.table(legacyTable)+`
+		LIMIT 1
+	`).WithContext(ctx).Scan(&version, &dirty); err != nil {
+		if err == gocql.ErrNotFound {
+			return applied, nil
+		}
+		return nil, fmt.Errorf("failed to read legacy migration version: %w", err)
+	}
+
+	if version > 0 && m.find(version) == nil {
+		return nil, fmt.Errorf("legacy migration version %d does not match any migration", version)
+	}
+
+	if record {
+		m.logger.Info("adopting legacy migration version", "version", version, "dirty", dirty)
+	}
+
+	now := time.Now().UTC()
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			break
+		}
+		migDirty := dirty && mig.Version == version
+		if record {
+			if err := m.record(ctx, mig, migDirty, now); err != nil {
+				return nil, err
+			}
+		}
+		applied[mig.Version] = &appliedMigration{
+			version:   mig.Version,
+			name:      mig.Name,
+			checksum:  mig.Checksum,
+			dirty:     migDirty,
+			appliedAt: now,
+		}
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,83 @@
+package migrate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Splits CQL into its statements on the semicolons that end them. Semicolons inside string literals, quoted
+// identifiers, $$ strings and comments don't end a statement. Statements are returned without their semicolon, and
+// ones that are empty or only comments are dropped.
+func splitStatements(cql string) ([]string, error) {
+	var (
+		statements []string
+		start      int
+		hasCode    bool
+	)
+
+	end := func(i int) {
+		if hasCode {
+			statements = append(statements, strings.TrimSpace(cql[start:i]))
+		}
+		start = i + 1
+		hasCode = false
+	}
+
+	for i := 0; i < len(cql); i++ {
+		c := cql[i]
+		switch {
+		case c == ';':
+			end(i)
+
+		case c == '\'' || c == '"':
+			// Quotes are escaped by doubling them
+			open := i
+			closed := false
+			for i++; i < len(cql); i++ {
+				if cql[i] == c {
+					if i+1 < len(cql) && cql[i+1] == c {
+						i++
+						continue
+					}
+					closed = true
+					break
+				}
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted string at offset %d", open)
+			}
+			hasCode = true
+
+		case strings.HasPrefix(cql[i:], "$$"):
+			idx := strings.Index(cql[i+2:], "$$")
+			if idx < 0 {
+				return nil, fmt.Errorf("unterminated $$ string at offset %d", i)
+			}
+			i += idx + 3
+			hasCode = true
+
+		case strings.HasPrefix(cql[i:], "--"), strings.HasPrefix(cql[i:], "//"):
+			idx := strings.IndexByte(cql[i:], '\n')
+			if idx < 0 {
+				i = len(cql)
+			} else {
+				i += idx
+			}
+
+		case strings.HasPrefix(cql[i:], "/*"):
+			idx := strings.Index(cql[i+2:], "*/")
+			if idx < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			i += idx + 3
+
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+
+		default:
+			hasCode = true
+		}
+	}
+	end(len(cql))
+
+	return statements, nil
+}
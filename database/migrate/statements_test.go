@@ -0,0 +1,141 @@
+package migrate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name string
+		cql  string
+		want []string
+	}{
+		{
+			name: "single statement",
+			cql:  "CREATE TABLE t (k INT PRIMARY KEY);\n",
+			want: []string{"CREATE TABLE t (k INT PRIMARY KEY)"},
+		},
+		{
+			name: "several statements",
+			cql:  "CREATE TABLE a (k INT PRIMARY KEY);\n\nCREATE TABLE b (k INT PRIMARY KEY);\n",
+			want: []string{"CREATE TABLE a (k INT PRIMARY KEY)", "CREATE TABLE b (k INT PRIMARY KEY)"},
+		},
+		{
+			name: "last statement without a semicolon",
+			cql:  "DROP TABLE a; DROP TABLE b",
+			want: []string{"DROP TABLE a", "DROP TABLE b"},
+		},
+		{
+			name: "empty statements are dropped",
+			cql:  " ;;\n\t;\r\n",
+			want: nil,
+		},
+		{
+			name: "empty input",
+			cql:  "",
+			want: nil,
+		},
+		{
+			name: "semicolon in a string",
+			cql:  "INSERT INTO t (k, v) VALUES (1, 'a;b'); DROP TABLE t;",
+			want: []string{"INSERT INTO t (k, v) VALUES (1, 'a;b')", "DROP TABLE t"},
+		},
+		{
+			name: "escaped quote in a string",
+			cql:  "INSERT INTO t (k, v) VALUES (1, 'it''s;'); DROP TABLE t;",
+			want: []string{"INSERT INTO t (k, v) VALUES (1, 'it''s;')", "DROP TABLE t"},
+		},
+		{
+			name: "comment markers in a string",
+			cql:  "INSERT INTO t (k, v) VALUES (1, '-- /* //;'); DROP TABLE t;",
+			want: []string{"INSERT INTO t (k, v) VALUES (1, '-- /* //;')", "DROP TABLE t"},
+		},
+		{
+			name: "semicolon in a quoted identifier",
+			cql:  `CREATE TABLE "odd;name" (k INT PRIMARY KEY); DROP TABLE "odd;name";`,
+			want: []string{`CREATE TABLE "odd;name" (k INT PRIMARY KEY)`, `DROP TABLE "odd;name"`},
+		},
+		{
+			name: "escaped quote in a quoted identifier",
+			cql:  `CREATE TABLE "a"";b" (k INT PRIMARY KEY);`,
+			want: []string{`CREATE TABLE "a"";b" (k INT PRIMARY KEY)`},
+		},
+		{
+			name: "semicolon in a $$ string",
+			cql:  "CREATE FUNCTION f() RETURNS NULL ON NULL INPUT RETURNS INT LANGUAGE java AS $$ return 1; $$; DROP FUNCTION f;",
+			want: []string{
+				"CREATE FUNCTION f() RETURNS NULL ON NULL INPUT RETURNS INT LANGUAGE java AS $$ return 1; $$",
+				"DROP FUNCTION f",
+			},
+		},
+		{
+			name: "quote in a $$ string",
+			cql:  "INSERT INTO t (k, v) VALUES (1, $$it's$$);",
+			want: []string{"INSERT INTO t (k, v) VALUES (1, $$it's$$)"},
+		},
+		{
+			name: "semicolon in a -- comment",
+			cql:  "DROP TABLE a; -- then; drop b\nDROP TABLE b;",
+			want: []string{"DROP TABLE a", "-- then; drop b\nDROP TABLE b"},
+		},
+		{
+			name: "semicolon in a // comment",
+			cql:  "DROP TABLE a; // then; drop b\nDROP TABLE b;",
+			want: []string{"DROP TABLE a", "// then; drop b\nDROP TABLE b"},
+		},
+		{
+			name: "semicolon in a block comment",
+			cql:  "DROP TABLE a /* ; */; /* multi\nline; */ DROP TABLE b;",
+			want: []string{"DROP TABLE a /* ; */", "/* multi\nline; */ DROP TABLE b"},
+		},
+		{
+			name: "quote in a comment",
+			cql:  "-- don't\nDROP TABLE a;",
+			want: []string{"-- don't\nDROP TABLE a"},
+		},
+		{
+			name: "comment only statements are dropped",
+			cql:  "DROP TABLE a;\n-- nothing else\n/* at all */;\n// really\n",
+			want: []string{"DROP TABLE a"},
+		},
+		{
+			name: "comment at the end without a newline",
+			cql:  "DROP TABLE a; -- done",
+			want: []string{"DROP TABLE a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitStatements(tt.cql)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitStatementsUnterminated(t *testing.T) {
+	tests := []struct {
+		name string
+		cql  string
+	}{
+		{"string", "INSERT INTO t (k, v) VALUES (1, 'a;"},
+		{"string ending in an escaped quote", "INSERT INTO t (k, v) VALUES (1, 'a''"},
+		{"quoted identifier", `CREATE TABLE "a (k INT PRIMARY KEY);`},
+		{"$$ string", "CREATE FUNCTION f() AS $$ return 1;"},
+		{"block comment", "DROP TABLE a; /* never closed;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := splitStatements(tt.cql); err == nil {
+				t.Fatalf("got %q, want an error", got)
+			}
+		})
+	}
+}
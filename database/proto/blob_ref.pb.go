@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -34,8 +35,13 @@ type BlobRef struct {
 	TakedownReason *string                `protobuf:"bytes,7,opt,name=takedown_reason,json=takedownReason,proto3,oneof" json:"takedown_reason,omitempty"`
 	TakenDownAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=taken_down_at,json=takenDownAt,proto3,oneof" json:"taken_down_at,omitempty"`
 	Tags           []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set when the last record using the blob stopped using it, and cleared if a record uses it again
+	UnreferencedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=unreferenced_at,json=unreferencedAt,proto3,oneof" json:"unreferenced_at,omitempty"`
+	// Set by garbage collection once the blob has been unreferenced for long enough that it shouldn't be served
+	Orphaned      bool                   `protobuf:"varint,11,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	OrphanedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=orphaned_at,json=orphanedAt,proto3,oneof" json:"orphaned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BlobRef) Reset() {
@@ -131,6 +137,27 @@ func (x *BlobRef) GetTags() []string {
 	return nil
 }
 
+func (x *BlobRef) GetUnreferencedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnreferencedAt
+	}
+	return nil
+}
+
+func (x *BlobRef) GetOrphaned() bool {
+	if x != nil {
+		return x.Orphaned
+	}
+	return false
+}
+
+func (x *BlobRef) GetOrphanedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrphanedAt
+	}
+	return nil
+}
+
 type GetBlobRefRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
@@ -185,7 +212,6 @@ func (x *GetBlobRefRequest) GetCid() string {
 
 type GetBlobRefResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	BlobRef       *BlobRef               `protobuf:"bytes,2,opt,name=blob_ref,json=blobRef,proto3,oneof" json:"blob_ref,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -221,13 +247,6 @@ func (*GetBlobRefResponse) Descriptor() ([]byte, []int) {
 	return file_blob_ref_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetBlobRefResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
 func (x *GetBlobRefResponse) GetBlobRef() *BlobRef {
 	if x != nil {
 		return x.BlobRef
@@ -281,7 +300,6 @@ func (x *CreateBlobRefRequest) GetBlobRef() *BlobRef {
 
 type CreateBlobRefResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -316,16 +334,12 @@ func (*CreateBlobRefResponse) Descriptor() ([]byte, []int) {
 	return file_blob_ref_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateBlobRefResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
 type UpdateBlobRefRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlobRef       *BlobRef               `protobuf:"bytes,1,opt,name=blob_ref,json=blobRef,proto3" json:"blob_ref,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BlobRef *BlobRef               `protobuf:"bytes,1,opt,name=blob_ref,json=blobRef,proto3" json:"blob_ref,omitempty"`
+	// Fields of the blob ref to update. If unset, every updatable field is replaced. An empty mask only updates
+	// updated_at.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -367,9 +381,15 @@ func (x *UpdateBlobRefRequest) GetBlobRef() *BlobRef {
 	return nil
 }
 
+func (x *UpdateBlobRefRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type UpdateBlobRefResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -404,18 +424,300 @@ func (*UpdateBlobRefResponse) Descriptor() ([]byte, []int) {
 	return file_blob_ref_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateBlobRefResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+type AddBlobRefTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Cid           string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlobRefTagsRequest) Reset() {
+	*x = AddBlobRefTagsRequest{}
+	mi := &file_blob_ref_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlobRefTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlobRefTagsRequest) ProtoMessage() {}
+
+func (x *AddBlobRefTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlobRefTagsRequest.ProtoReflect.Descriptor instead.
+func (*AddBlobRefTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddBlobRefTagsRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *AddBlobRefTagsRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AddBlobRefTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddBlobRefTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlobRefTagsResponse) Reset() {
+	*x = AddBlobRefTagsResponse{}
+	mi := &file_blob_ref_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlobRefTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlobRefTagsResponse) ProtoMessage() {}
+
+func (x *AddBlobRefTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlobRefTagsResponse.ProtoReflect.Descriptor instead.
+func (*AddBlobRefTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{8}
+}
+
+type RemoveBlobRefTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Cid           string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlobRefTagsRequest) Reset() {
+	*x = RemoveBlobRefTagsRequest{}
+	mi := &file_blob_ref_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlobRefTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlobRefTagsRequest) ProtoMessage() {}
+
+func (x *RemoveBlobRefTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlobRefTagsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBlobRefTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemoveBlobRefTagsRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *RemoveBlobRefTagsRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
 	}
 	return ""
 }
 
+func (x *RemoveBlobRefTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RemoveBlobRefTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlobRefTagsResponse) Reset() {
+	*x = RemoveBlobRefTagsResponse{}
+	mi := &file_blob_ref_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlobRefTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlobRefTagsResponse) ProtoMessage() {}
+
+func (x *RemoveBlobRefTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlobRefTagsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBlobRefTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{10}
+}
+
+// Replaces the set of blobs used by a record. Deleted records are given no CIDs.
+type SetRecordBlobUsagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	RecordUri     string                 `protobuf:"bytes,2,opt,name=record_uri,json=recordUri,proto3" json:"record_uri,omitempty"`
+	Cids          []string               `protobuf:"bytes,3,rep,name=cids,proto3" json:"cids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecordBlobUsagesRequest) Reset() {
+	*x = SetRecordBlobUsagesRequest{}
+	mi := &file_blob_ref_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecordBlobUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecordBlobUsagesRequest) ProtoMessage() {}
+
+func (x *SetRecordBlobUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecordBlobUsagesRequest.ProtoReflect.Descriptor instead.
+func (*SetRecordBlobUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetRecordBlobUsagesRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *SetRecordBlobUsagesRequest) GetRecordUri() string {
+	if x != nil {
+		return x.RecordUri
+	}
+	return ""
+}
+
+func (x *SetRecordBlobUsagesRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type SetRecordBlobUsagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecordBlobUsagesResponse) Reset() {
+	*x = SetRecordBlobUsagesResponse{}
+	mi := &file_blob_ref_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecordBlobUsagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecordBlobUsagesResponse) ProtoMessage() {}
+
+func (x *SetRecordBlobUsagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blob_ref_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecordBlobUsagesResponse.ProtoReflect.Descriptor instead.
+func (*SetRecordBlobUsagesResponse) Descriptor() ([]byte, []int) {
+	return file_blob_ref_proto_rawDescGZIP(), []int{12}
+}
+
 var File_blob_ref_proto protoreflect.FileDescriptor
 
 const file_blob_ref_proto_rawDesc = "" +
 	"\n" +
-	"\x0eblob_ref.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x03\n" +
+	"\x0eblob_ref.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x05\n" +
 	"\aBlobRef\x12\x18\n" +
 	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x18\n" +
 	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12>\n" +
@@ -427,34 +729,56 @@ const file_blob_ref_proto_rawDesc = "" +
 	"taken_down\x18\x06 \x01(\bR\ttakenDown\x12,\n" +
 	"\x0ftakedown_reason\x18\a \x01(\tH\x02R\x0etakedownReason\x88\x01\x01\x12C\n" +
 	"\rtaken_down_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x03R\vtakenDownAt\x88\x01\x01\x12\x12\n" +
-	"\x04tags\x18\t \x03(\tR\x04tagsB\x0f\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12H\n" +
+	"\x0funreferenced_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0eunreferencedAt\x88\x01\x01\x12\x1a\n" +
+	"\borphaned\x18\v \x01(\bR\borphaned\x12@\n" +
+	"\vorphaned_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x05R\n" +
+	"orphanedAt\x88\x01\x01B\x0f\n" +
 	"\r_processed_atB\r\n" +
 	"\v_updated_atB\x12\n" +
 	"\x10_takedown_reasonB\x10\n" +
-	"\x0e_taken_down_at\"G\n" +
+	"\x0e_taken_down_atB\x12\n" +
+	"\x10_unreferenced_atB\x0e\n" +
+	"\f_orphaned_at\"G\n" +
 	"\x11GetBlobRefRequest\x12\x18\n" +
 	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x18\n" +
-	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\"~\n" +
-	"\x12GetBlobRefResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x126\n" +
-	"\bblob_ref\x18\x02 \x01(\v2\x16.vyletdatabase.BlobRefH\x01R\ablobRef\x88\x01\x01B\b\n" +
-	"\x06_errorB\v\n" +
-	"\t_blob_ref\"I\n" +
+	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\"f\n" +
+	"\x12GetBlobRefResponse\x126\n" +
+	"\bblob_ref\x18\x02 \x01(\v2\x16.vyletdatabase.BlobRefH\x00R\ablobRef\x88\x01\x01B\v\n" +
+	"\t_blob_refJ\x04\b\x01\x10\x02R\x05error\"I\n" +
 	"\x14CreateBlobRefRequest\x121\n" +
-	"\bblob_ref\x18\x01 \x01(\v2\x16.vyletdatabase.BlobRefR\ablobRef\"<\n" +
-	"\x15CreateBlobRefResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"I\n" +
+	"\bblob_ref\x18\x01 \x01(\v2\x16.vyletdatabase.BlobRefR\ablobRef\"$\n" +
+	"\x15CreateBlobRefResponseJ\x04\b\x01\x10\x02R\x05error\"\x86\x01\n" +
 	"\x14UpdateBlobRefRequest\x121\n" +
-	"\bblob_ref\x18\x01 \x01(\v2\x16.vyletdatabase.BlobRefR\ablobRef\"<\n" +
-	"\x15UpdateBlobRefResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error2\x9b\x02\n" +
-	"\x0eBlobRefService\x12Q\n" +
+	"\bblob_ref\x18\x01 \x01(\v2\x16.vyletdatabase.BlobRefR\ablobRef\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"$\n" +
+	"\x15UpdateBlobRefResponseJ\x04\b\x01\x10\x02R\x05error\"_\n" +
+	"\x15AddBlobRefTagsRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x18\n" +
+	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"%\n" +
+	"\x16AddBlobRefTagsResponseJ\x04\b\x01\x10\x02R\x05error\"b\n" +
+	"\x18RemoveBlobRefTagsRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x18\n" +
+	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"(\n" +
+	"\x19RemoveBlobRefTagsResponseJ\x04\b\x01\x10\x02R\x05error\"q\n" +
+	"\x1aSetRecordBlobUsagesRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12%\n" +
+	"\n" +
+	"record_uri\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\trecordUri\x12\x12\n" +
+	"\x04cids\x18\x03 \x03(\tR\x04cids\"*\n" +
+	"\x1bSetRecordBlobUsagesResponseJ\x04\b\x01\x10\x02R\x05error2\xd5\x04\n" +
+	"\x0eBlobRefService\x12V\n" +
 	"\n" +
-	"GetBlobRef\x12 .vyletdatabase.GetBlobRefRequest\x1a!.vyletdatabase.GetBlobRefResponse\x12Z\n" +
+	"GetBlobRef\x12 .vyletdatabase.GetBlobRefRequest\x1a!.vyletdatabase.GetBlobRefResponse\"\x03\x90\x02\x01\x12Z\n" +
 	"\rCreateBlobRef\x12#.vyletdatabase.CreateBlobRefRequest\x1a$.vyletdatabase.CreateBlobRefResponse\x12Z\n" +
-	"\rUpdateBlobRef\x12#.vyletdatabase.UpdateBlobRefRequest\x1a$.vyletdatabase.UpdateBlobRefResponseB\x87\x01\n" +
+	"\rUpdateBlobRef\x12#.vyletdatabase.UpdateBlobRefRequest\x1a$.vyletdatabase.UpdateBlobRefResponse\x12]\n" +
+	"\x0eAddBlobRefTags\x12$.vyletdatabase.AddBlobRefTagsRequest\x1a%.vyletdatabase.AddBlobRefTagsResponse\x12f\n" +
+	"\x11RemoveBlobRefTags\x12'.vyletdatabase.RemoveBlobRefTagsRequest\x1a(.vyletdatabase.RemoveBlobRefTagsResponse\x12l\n" +
+	"\x13SetRecordBlobUsages\x12).vyletdatabase.SetRecordBlobUsagesRequest\x1a*.vyletdatabase.SetRecordBlobUsagesResponseB\x87\x01\n" +
 	"\x11com.vyletdatabaseB\fBlobRefProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
 
 var (
@@ -469,36 +793,52 @@ func file_blob_ref_proto_rawDescGZIP() []byte {
 	return file_blob_ref_proto_rawDescData
 }
 
-var file_blob_ref_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_blob_ref_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_blob_ref_proto_goTypes = []any{
-	(*BlobRef)(nil),               // 0: vyletdatabase.BlobRef
-	(*GetBlobRefRequest)(nil),     // 1: vyletdatabase.GetBlobRefRequest
-	(*GetBlobRefResponse)(nil),    // 2: vyletdatabase.GetBlobRefResponse
-	(*CreateBlobRefRequest)(nil),  // 3: vyletdatabase.CreateBlobRefRequest
-	(*CreateBlobRefResponse)(nil), // 4: vyletdatabase.CreateBlobRefResponse
-	(*UpdateBlobRefRequest)(nil),  // 5: vyletdatabase.UpdateBlobRefRequest
-	(*UpdateBlobRefResponse)(nil), // 6: vyletdatabase.UpdateBlobRefResponse
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*BlobRef)(nil),                     // 0: vyletdatabase.BlobRef
+	(*GetBlobRefRequest)(nil),           // 1: vyletdatabase.GetBlobRefRequest
+	(*GetBlobRefResponse)(nil),          // 2: vyletdatabase.GetBlobRefResponse
+	(*CreateBlobRefRequest)(nil),        // 3: vyletdatabase.CreateBlobRefRequest
+	(*CreateBlobRefResponse)(nil),       // 4: vyletdatabase.CreateBlobRefResponse
+	(*UpdateBlobRefRequest)(nil),        // 5: vyletdatabase.UpdateBlobRefRequest
+	(*UpdateBlobRefResponse)(nil),       // 6: vyletdatabase.UpdateBlobRefResponse
+	(*AddBlobRefTagsRequest)(nil),       // 7: vyletdatabase.AddBlobRefTagsRequest
+	(*AddBlobRefTagsResponse)(nil),      // 8: vyletdatabase.AddBlobRefTagsResponse
+	(*RemoveBlobRefTagsRequest)(nil),    // 9: vyletdatabase.RemoveBlobRefTagsRequest
+	(*RemoveBlobRefTagsResponse)(nil),   // 10: vyletdatabase.RemoveBlobRefTagsResponse
+	(*SetRecordBlobUsagesRequest)(nil),  // 11: vyletdatabase.SetRecordBlobUsagesRequest
+	(*SetRecordBlobUsagesResponse)(nil), // 12: vyletdatabase.SetRecordBlobUsagesResponse
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 14: google.protobuf.FieldMask
 }
 var file_blob_ref_proto_depIdxs = []int32{
-	7,  // 0: vyletdatabase.BlobRef.first_seen_at:type_name -> google.protobuf.Timestamp
-	7,  // 1: vyletdatabase.BlobRef.processed_at:type_name -> google.protobuf.Timestamp
-	7,  // 2: vyletdatabase.BlobRef.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 3: vyletdatabase.BlobRef.taken_down_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: vyletdatabase.GetBlobRefResponse.blob_ref:type_name -> vyletdatabase.BlobRef
-	0,  // 5: vyletdatabase.CreateBlobRefRequest.blob_ref:type_name -> vyletdatabase.BlobRef
-	0,  // 6: vyletdatabase.UpdateBlobRefRequest.blob_ref:type_name -> vyletdatabase.BlobRef
-	1,  // 7: vyletdatabase.BlobRefService.GetBlobRef:input_type -> vyletdatabase.GetBlobRefRequest
-	3,  // 8: vyletdatabase.BlobRefService.CreateBlobRef:input_type -> vyletdatabase.CreateBlobRefRequest
-	5,  // 9: vyletdatabase.BlobRefService.UpdateBlobRef:input_type -> vyletdatabase.UpdateBlobRefRequest
-	2,  // 10: vyletdatabase.BlobRefService.GetBlobRef:output_type -> vyletdatabase.GetBlobRefResponse
-	4,  // 11: vyletdatabase.BlobRefService.CreateBlobRef:output_type -> vyletdatabase.CreateBlobRefResponse
-	6,  // 12: vyletdatabase.BlobRefService.UpdateBlobRef:output_type -> vyletdatabase.UpdateBlobRefResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	13, // 0: vyletdatabase.BlobRef.first_seen_at:type_name -> google.protobuf.Timestamp
+	13, // 1: vyletdatabase.BlobRef.processed_at:type_name -> google.protobuf.Timestamp
+	13, // 2: vyletdatabase.BlobRef.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 3: vyletdatabase.BlobRef.taken_down_at:type_name -> google.protobuf.Timestamp
+	13, // 4: vyletdatabase.BlobRef.unreferenced_at:type_name -> google.protobuf.Timestamp
+	13, // 5: vyletdatabase.BlobRef.orphaned_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: vyletdatabase.GetBlobRefResponse.blob_ref:type_name -> vyletdatabase.BlobRef
+	0,  // 7: vyletdatabase.CreateBlobRefRequest.blob_ref:type_name -> vyletdatabase.BlobRef
+	0,  // 8: vyletdatabase.UpdateBlobRefRequest.blob_ref:type_name -> vyletdatabase.BlobRef
+	14, // 9: vyletdatabase.UpdateBlobRefRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 10: vyletdatabase.BlobRefService.GetBlobRef:input_type -> vyletdatabase.GetBlobRefRequest
+	3,  // 11: vyletdatabase.BlobRefService.CreateBlobRef:input_type -> vyletdatabase.CreateBlobRefRequest
+	5,  // 12: vyletdatabase.BlobRefService.UpdateBlobRef:input_type -> vyletdatabase.UpdateBlobRefRequest
+	7,  // 13: vyletdatabase.BlobRefService.AddBlobRefTags:input_type -> vyletdatabase.AddBlobRefTagsRequest
+	9,  // 14: vyletdatabase.BlobRefService.RemoveBlobRefTags:input_type -> vyletdatabase.RemoveBlobRefTagsRequest
+	11, // 15: vyletdatabase.BlobRefService.SetRecordBlobUsages:input_type -> vyletdatabase.SetRecordBlobUsagesRequest
+	2,  // 16: vyletdatabase.BlobRefService.GetBlobRef:output_type -> vyletdatabase.GetBlobRefResponse
+	4,  // 17: vyletdatabase.BlobRefService.CreateBlobRef:output_type -> vyletdatabase.CreateBlobRefResponse
+	6,  // 18: vyletdatabase.BlobRefService.UpdateBlobRef:output_type -> vyletdatabase.UpdateBlobRefResponse
+	8,  // 19: vyletdatabase.BlobRefService.AddBlobRefTags:output_type -> vyletdatabase.AddBlobRefTagsResponse
+	10, // 20: vyletdatabase.BlobRefService.RemoveBlobRefTags:output_type -> vyletdatabase.RemoveBlobRefTagsResponse
+	12, // 21: vyletdatabase.BlobRefService.SetRecordBlobUsages:output_type -> vyletdatabase.SetRecordBlobUsagesResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_blob_ref_proto_init() }
@@ -508,15 +848,13 @@ func file_blob_ref_proto_init() {
 	}
 	file_blob_ref_proto_msgTypes[0].OneofWrappers = []any{}
 	file_blob_ref_proto_msgTypes[2].OneofWrappers = []any{}
-	file_blob_ref_proto_msgTypes[4].OneofWrappers = []any{}
-	file_blob_ref_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blob_ref_proto_rawDesc), len(file_blob_ref_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
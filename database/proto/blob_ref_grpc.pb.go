@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BlobRefService_GetBlobRef_FullMethodName    = "/vyletdatabase.BlobRefService/GetBlobRef"
-	BlobRefService_CreateBlobRef_FullMethodName = "/vyletdatabase.BlobRefService/CreateBlobRef"
-	BlobRefService_UpdateBlobRef_FullMethodName = "/vyletdatabase.BlobRefService/UpdateBlobRef"
+	BlobRefService_GetBlobRef_FullMethodName          = "/vyletdatabase.BlobRefService/GetBlobRef"
+	BlobRefService_CreateBlobRef_FullMethodName       = "/vyletdatabase.BlobRefService/CreateBlobRef"
+	BlobRefService_UpdateBlobRef_FullMethodName       = "/vyletdatabase.BlobRefService/UpdateBlobRef"
+	BlobRefService_AddBlobRefTags_FullMethodName      = "/vyletdatabase.BlobRefService/AddBlobRefTags"
+	BlobRefService_RemoveBlobRefTags_FullMethodName   = "/vyletdatabase.BlobRefService/RemoveBlobRefTags"
+	BlobRefService_SetRecordBlobUsages_FullMethodName = "/vyletdatabase.BlobRefService/SetRecordBlobUsages"
 )
 
 // BlobRefServiceClient is the client API for BlobRefService service.
@@ -31,6 +34,9 @@ type BlobRefServiceClient interface {
 	GetBlobRef(ctx context.Context, in *GetBlobRefRequest, opts ...grpc.CallOption) (*GetBlobRefResponse, error)
 	CreateBlobRef(ctx context.Context, in *CreateBlobRefRequest, opts ...grpc.CallOption) (*CreateBlobRefResponse, error)
 	UpdateBlobRef(ctx context.Context, in *UpdateBlobRefRequest, opts ...grpc.CallOption) (*UpdateBlobRefResponse, error)
+	AddBlobRefTags(ctx context.Context, in *AddBlobRefTagsRequest, opts ...grpc.CallOption) (*AddBlobRefTagsResponse, error)
+	RemoveBlobRefTags(ctx context.Context, in *RemoveBlobRefTagsRequest, opts ...grpc.CallOption) (*RemoveBlobRefTagsResponse, error)
+	SetRecordBlobUsages(ctx context.Context, in *SetRecordBlobUsagesRequest, opts ...grpc.CallOption) (*SetRecordBlobUsagesResponse, error)
 }
 
 type blobRefServiceClient struct {
@@ -71,6 +77,36 @@ func (c *blobRefServiceClient) UpdateBlobRef(ctx context.Context, in *UpdateBlob
 	return out, nil
 }
 
+func (c *blobRefServiceClient) AddBlobRefTags(ctx context.Context, in *AddBlobRefTagsRequest, opts ...grpc.CallOption) (*AddBlobRefTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBlobRefTagsResponse)
+	err := c.cc.Invoke(ctx, BlobRefService_AddBlobRefTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobRefServiceClient) RemoveBlobRefTags(ctx context.Context, in *RemoveBlobRefTagsRequest, opts ...grpc.CallOption) (*RemoveBlobRefTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBlobRefTagsResponse)
+	err := c.cc.Invoke(ctx, BlobRefService_RemoveBlobRefTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobRefServiceClient) SetRecordBlobUsages(ctx context.Context, in *SetRecordBlobUsagesRequest, opts ...grpc.CallOption) (*SetRecordBlobUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecordBlobUsagesResponse)
+	err := c.cc.Invoke(ctx, BlobRefService_SetRecordBlobUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlobRefServiceServer is the server API for BlobRefService service.
 // All implementations must embed UnimplementedBlobRefServiceServer
 // for forward compatibility.
@@ -78,6 +114,9 @@ type BlobRefServiceServer interface {
 	GetBlobRef(context.Context, *GetBlobRefRequest) (*GetBlobRefResponse, error)
 	CreateBlobRef(context.Context, *CreateBlobRefRequest) (*CreateBlobRefResponse, error)
 	UpdateBlobRef(context.Context, *UpdateBlobRefRequest) (*UpdateBlobRefResponse, error)
+	AddBlobRefTags(context.Context, *AddBlobRefTagsRequest) (*AddBlobRefTagsResponse, error)
+	RemoveBlobRefTags(context.Context, *RemoveBlobRefTagsRequest) (*RemoveBlobRefTagsResponse, error)
+	SetRecordBlobUsages(context.Context, *SetRecordBlobUsagesRequest) (*SetRecordBlobUsagesResponse, error)
 	mustEmbedUnimplementedBlobRefServiceServer()
 }
 
@@ -97,6 +136,15 @@ func (UnimplementedBlobRefServiceServer) CreateBlobRef(context.Context, *CreateB
 func (UnimplementedBlobRefServiceServer) UpdateBlobRef(context.Context, *UpdateBlobRefRequest) (*UpdateBlobRefResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateBlobRef not implemented")
 }
+func (UnimplementedBlobRefServiceServer) AddBlobRefTags(context.Context, *AddBlobRefTagsRequest) (*AddBlobRefTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddBlobRefTags not implemented")
+}
+func (UnimplementedBlobRefServiceServer) RemoveBlobRefTags(context.Context, *RemoveBlobRefTagsRequest) (*RemoveBlobRefTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveBlobRefTags not implemented")
+}
+func (UnimplementedBlobRefServiceServer) SetRecordBlobUsages(context.Context, *SetRecordBlobUsagesRequest) (*SetRecordBlobUsagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRecordBlobUsages not implemented")
+}
 func (UnimplementedBlobRefServiceServer) mustEmbedUnimplementedBlobRefServiceServer() {}
 func (UnimplementedBlobRefServiceServer) testEmbeddedByValue()                        {}
 
@@ -172,6 +220,60 @@ func _BlobRefService_UpdateBlobRef_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlobRefService_AddBlobRefTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlobRefTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobRefServiceServer).AddBlobRefTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobRefService_AddBlobRefTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobRefServiceServer).AddBlobRefTags(ctx, req.(*AddBlobRefTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobRefService_RemoveBlobRefTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBlobRefTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobRefServiceServer).RemoveBlobRefTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobRefService_RemoveBlobRefTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobRefServiceServer).RemoveBlobRefTags(ctx, req.(*RemoveBlobRefTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobRefService_SetRecordBlobUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecordBlobUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobRefServiceServer).SetRecordBlobUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobRefService_SetRecordBlobUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobRefServiceServer).SetRecordBlobUsages(ctx, req.(*SetRecordBlobUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlobRefService_ServiceDesc is the grpc.ServiceDesc for BlobRefService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +293,18 @@ var BlobRefService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBlobRef",
 			Handler:    _BlobRefService_UpdateBlobRef_Handler,
 		},
+		{
+			MethodName: "AddBlobRefTags",
+			Handler:    _BlobRefService_AddBlobRefTags_Handler,
+		},
+		{
+			MethodName: "RemoveBlobRefTags",
+			Handler:    _BlobRefService_RemoveBlobRefTags_Handler,
+		},
+		{
+			MethodName: "SetRecordBlobUsages",
+			Handler:    _BlobRefService_SetRecordBlobUsages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blob_ref.proto",
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: errors.proto
+
+package vyletdatabase
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Errors are returned as gRPC statuses with a google.rpc.ErrorInfo detail, whose domain is "database.vylet.app" and
+// whose reason is one of these. Reasons let clients tell apart errors that share a status code.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// The requested row doesn't exist. Returned with NotFound.
+	ErrorReason_ERROR_REASON_NOT_FOUND ErrorReason = 1
+	// The request's cursor is malformed, was tampered with, or was issued for another listing. Returned with
+	// InvalidArgument.
+	ErrorReason_ERROR_REASON_INVALID_CURSOR ErrorReason = 2
+	// Any other invalid request. Returned with InvalidArgument.
+	ErrorReason_ERROR_REASON_INVALID_ARGUMENT ErrorReason = 3
+	// Cassandra couldn't be reached or didn't have enough replicas to serve the request. Returned with Unavailable, and
+	// safe to retry.
+	ErrorReason_ERROR_REASON_DATABASE_UNAVAILABLE ErrorReason = 4
+	// Cassandra didn't respond in time. Returned with DeadlineExceeded.
+	ErrorReason_ERROR_REASON_DATABASE_TIMEOUT ErrorReason = 5
+	// Any other failure. Returned with Internal.
+	ErrorReason_ERROR_REASON_INTERNAL ErrorReason = 6
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "ERROR_REASON_UNSPECIFIED",
+		1: "ERROR_REASON_NOT_FOUND",
+		2: "ERROR_REASON_INVALID_CURSOR",
+		3: "ERROR_REASON_INVALID_ARGUMENT",
+		4: "ERROR_REASON_DATABASE_UNAVAILABLE",
+		5: "ERROR_REASON_DATABASE_TIMEOUT",
+		6: "ERROR_REASON_INTERNAL",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":          0,
+		"ERROR_REASON_NOT_FOUND":            1,
+		"ERROR_REASON_INVALID_CURSOR":       2,
+		"ERROR_REASON_INVALID_ARGUMENT":     3,
+		"ERROR_REASON_DATABASE_UNAVAILABLE": 4,
+		"ERROR_REASON_DATABASE_TIMEOUT":     5,
+		"ERROR_REASON_INTERNAL":             6,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_errors_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_errors_proto_rawDescGZIP(), []int{0}
+}
+
+var File_errors_proto protoreflect.FileDescriptor
+
+const file_errors_proto_rawDesc = "" +
+	"\n" +
+	"\ferrors.proto\x12\rvyletdatabase*\xf0\x01\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ERROR_REASON_NOT_FOUND\x10\x01\x12\x1f\n" +
+	"\x1bERROR_REASON_INVALID_CURSOR\x10\x02\x12!\n" +
+	"\x1dERROR_REASON_INVALID_ARGUMENT\x10\x03\x12%\n" +
+	"!ERROR_REASON_DATABASE_UNAVAILABLE\x10\x04\x12!\n" +
+	"\x1dERROR_REASON_DATABASE_TIMEOUT\x10\x05\x12\x19\n" +
+	"\x15ERROR_REASON_INTERNAL\x10\x06B\x86\x01\n" +
+	"\x11com.vyletdatabaseB\vErrorsProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
+
+var (
+	file_errors_proto_rawDescOnce sync.Once
+	file_errors_proto_rawDescData []byte
+)
+
+func file_errors_proto_rawDescGZIP() []byte {
+	file_errors_proto_rawDescOnce.Do(func() {
+		file_errors_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_errors_proto_rawDesc), len(file_errors_proto_rawDesc)))
+	})
+	return file_errors_proto_rawDescData
+}
+
+var file_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_errors_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: vyletdatabase.ErrorReason
+}
+var file_errors_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_errors_proto_init() }
+func file_errors_proto_init() {
+	if File_errors_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_errors_proto_rawDesc), len(file_errors_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_errors_proto_goTypes,
+		DependencyIndexes: file_errors_proto_depIdxs,
+		EnumInfos:         file_errors_proto_enumTypes,
+	}.Build()
+	File_errors_proto = out.File
+	file_errors_proto_goTypes = nil
+	file_errors_proto_depIdxs = nil
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: follow.proto
+
+package vyletdatabase
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Follow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cid           string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	SubjectDid    string                 `protobuf:"bytes,3,opt,name=subject_did,json=subjectDid,proto3" json:"subject_did,omitempty"`
+	AuthorDid     string                 `protobuf:"bytes,4,opt,name=author_did,json=authorDid,proto3" json:"author_did,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IndexedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	mi := &file_follow_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Follow) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Follow) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *Follow) GetSubjectDid() string {
+	if x != nil {
+		return x.SubjectDid
+	}
+	return ""
+}
+
+func (x *Follow) GetAuthorDid() string {
+	if x != nil {
+		return x.AuthorDid
+	}
+	return ""
+}
+
+func (x *Follow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Follow) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+type CreateFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *Follow                `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFollowRequest) Reset() {
+	*x = CreateFollowRequest{}
+	mi := &file_follow_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFollowRequest) ProtoMessage() {}
+
+func (x *CreateFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFollowRequest.ProtoReflect.Descriptor instead.
+func (*CreateFollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFollowRequest) GetFollow() *Follow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+type CreateFollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFollowResponse) Reset() {
+	*x = CreateFollowResponse{}
+	mi := &file_follow_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFollowResponse) ProtoMessage() {}
+
+func (x *CreateFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFollowResponse.ProtoReflect.Descriptor instead.
+func (*CreateFollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFollowRequest) Reset() {
+	*x = DeleteFollowRequest{}
+	mi := &file_follow_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFollowRequest) ProtoMessage() {}
+
+func (x *DeleteFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFollowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteFollowRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type DeleteFollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFollowResponse) Reset() {
+	*x = DeleteFollowResponse{}
+	mi := &file_follow_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFollowResponse) ProtoMessage() {}
+
+func (x *DeleteFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFollowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{4}
+}
+
+// The follows between an actor and one other account
+type FollowRelationship struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URI of the actor's follow of the account, if any
+	Following *string `protobuf:"bytes,1,opt,name=following,proto3,oneof" json:"following,omitempty"`
+	// URI of the account's follow of the actor, if any
+	FollowedBy    *string `protobuf:"bytes,2,opt,name=followed_by,json=followedBy,proto3,oneof" json:"followed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRelationship) Reset() {
+	*x = FollowRelationship{}
+	mi := &file_follow_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRelationship) ProtoMessage() {}
+
+func (x *FollowRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRelationship.ProtoReflect.Descriptor instead.
+func (*FollowRelationship) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FollowRelationship) GetFollowing() string {
+	if x != nil && x.Following != nil {
+		return *x.Following
+	}
+	return ""
+}
+
+func (x *FollowRelationship) GetFollowedBy() string {
+	if x != nil && x.FollowedBy != nil {
+		return *x.FollowedBy
+	}
+	return ""
+}
+
+type GetFollowRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	OtherDids     []string               `protobuf:"bytes,2,rep,name=other_dids,json=otherDids,proto3" json:"other_dids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRelationshipsRequest) Reset() {
+	*x = GetFollowRelationshipsRequest{}
+	mi := &file_follow_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetFollowRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFollowRelationshipsRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *GetFollowRelationshipsRequest) GetOtherDids() []string {
+	if x != nil {
+		return x.OtherDids
+	}
+	return nil
+}
+
+type GetFollowRelationshipsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keyed by each of the other DIDs. DIDs with no follow in either direction are omitted.
+	Relationships map[string]*FollowRelationship `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRelationshipsResponse) Reset() {
+	*x = GetFollowRelationshipsResponse{}
+	mi := &file_follow_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetFollowRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFollowRelationshipsResponse) GetRelationships() map[string]*FollowRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+var File_follow_proto protoreflect.FileDescriptor
+
+const file_follow_proto_rawDesc = "" +
+	"\n" +
+	"\ffollow.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x01\n" +
+	"\x06Follow\x12\x18\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\x12\x18\n" +
+	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12'\n" +
+	"\vsubject_did\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"subjectDid\x12\x1d\n" +
+	"\n" +
+	"author_did\x18\x04 \x01(\tR\tauthorDid\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"indexed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt\"D\n" +
+	"\x13CreateFollowRequest\x12-\n" +
+	"\x06follow\x18\x01 \x01(\v2\x15.vyletdatabase.FollowR\x06follow\"#\n" +
+	"\x14CreateFollowResponseJ\x04\b\x01\x10\x02R\x05error\"/\n" +
+	"\x13DeleteFollowRequest\x12\x18\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"#\n" +
+	"\x14DeleteFollowResponseJ\x04\b\x01\x10\x02R\x05error\"{\n" +
+	"\x12FollowRelationship\x12!\n" +
+	"\tfollowing\x18\x01 \x01(\tH\x00R\tfollowing\x88\x01\x01\x12$\n" +
+	"\vfollowed_by\x18\x02 \x01(\tH\x01R\n" +
+	"followedBy\x88\x01\x01B\f\n" +
+	"\n" +
+	"_followingB\x0e\n" +
+	"\f_followed_by\"X\n" +
+	"\x1dGetFollowRelationshipsRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x1d\n" +
+	"\n" +
+	"other_dids\x18\x02 \x03(\tR\totherDids\"\xfa\x01\n" +
+	"\x1eGetFollowRelationshipsResponse\x12f\n" +
+	"\rrelationships\x18\x02 \x03(\v2@.vyletdatabase.GetFollowRelationshipsResponse.RelationshipsEntryR\rrelationships\x1ac\n" +
+	"\x12RelationshipsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.vyletdatabase.FollowRelationshipR\x05value:\x028\x01J\x04\b\x01\x10\x02R\x05error2\xbd\x02\n" +
+	"\rFollowService\x12W\n" +
+	"\fCreateFollow\x12\".vyletdatabase.CreateFollowRequest\x1a#.vyletdatabase.CreateFollowResponse\x12W\n" +
+	"\fDeleteFollow\x12\".vyletdatabase.DeleteFollowRequest\x1a#.vyletdatabase.DeleteFollowResponse\x12z\n" +
+	"\x16GetFollowRelationships\x12,.vyletdatabase.GetFollowRelationshipsRequest\x1a-.vyletdatabase.GetFollowRelationshipsResponse\"\x03\x90\x02\x01B\x86\x01\n" +
+	"\x11com.vyletdatabaseB\vFollowProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
+
+var (
+	file_follow_proto_rawDescOnce sync.Once
+	file_follow_proto_rawDescData []byte
+)
+
+func file_follow_proto_rawDescGZIP() []byte {
+	file_follow_proto_rawDescOnce.Do(func() {
+		file_follow_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)))
+	})
+	return file_follow_proto_rawDescData
+}
+
+var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_follow_proto_goTypes = []any{
+	(*Follow)(nil),                         // 0: vyletdatabase.Follow
+	(*CreateFollowRequest)(nil),            // 1: vyletdatabase.CreateFollowRequest
+	(*CreateFollowResponse)(nil),           // 2: vyletdatabase.CreateFollowResponse
+	(*DeleteFollowRequest)(nil),            // 3: vyletdatabase.DeleteFollowRequest
+	(*DeleteFollowResponse)(nil),           // 4: vyletdatabase.DeleteFollowResponse
+	(*FollowRelationship)(nil),             // 5: vyletdatabase.FollowRelationship
+	(*GetFollowRelationshipsRequest)(nil),  // 6: vyletdatabase.GetFollowRelationshipsRequest
+	(*GetFollowRelationshipsResponse)(nil), // 7: vyletdatabase.GetFollowRelationshipsResponse
+	nil,                                    // 8: vyletdatabase.GetFollowRelationshipsResponse.RelationshipsEntry
+	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+}
+var file_follow_proto_depIdxs = []int32{
+	9, // 0: vyletdatabase.Follow.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: vyletdatabase.Follow.indexed_at:type_name -> google.protobuf.Timestamp
+	0, // 2: vyletdatabase.CreateFollowRequest.follow:type_name -> vyletdatabase.Follow
+	8, // 3: vyletdatabase.GetFollowRelationshipsResponse.relationships:type_name -> vyletdatabase.GetFollowRelationshipsResponse.RelationshipsEntry
+	5, // 4: vyletdatabase.GetFollowRelationshipsResponse.RelationshipsEntry.value:type_name -> vyletdatabase.FollowRelationship
+	1, // 5: vyletdatabase.FollowService.CreateFollow:input_type -> vyletdatabase.CreateFollowRequest
+	3, // 6: vyletdatabase.FollowService.DeleteFollow:input_type -> vyletdatabase.DeleteFollowRequest
+	6, // 7: vyletdatabase.FollowService.GetFollowRelationships:input_type -> vyletdatabase.GetFollowRelationshipsRequest
+	2, // 8: vyletdatabase.FollowService.CreateFollow:output_type -> vyletdatabase.CreateFollowResponse
+	4, // 9: vyletdatabase.FollowService.DeleteFollow:output_type -> vyletdatabase.DeleteFollowResponse
+	7, // 10: vyletdatabase.FollowService.GetFollowRelationships:output_type -> vyletdatabase.GetFollowRelationshipsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_follow_proto_init() }
+func file_follow_proto_init() {
+	if File_follow_proto != nil {
+		return
+	}
+	file_follow_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_follow_proto_goTypes,
+		DependencyIndexes: file_follow_proto_depIdxs,
+		MessageInfos:      file_follow_proto_msgTypes,
+	}.Build()
+	File_follow_proto = out.File
+	file_follow_proto_goTypes = nil
+	file_follow_proto_depIdxs = nil
+}
@@ -161,7 +161,6 @@ func (x *CreateLikeRequest) GetLike() *Like {
 
 type CreateLikeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,13 +195,6 @@ func (*CreateLikeResponse) Descriptor() ([]byte, []int) {
 	return file_like_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateLikeResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
 type DeleteLikeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
@@ -249,7 +241,6 @@ func (x *DeleteLikeRequest) GetUri() string {
 
 type DeleteLikeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -284,13 +275,6 @@ func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
 	return file_like_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteLikeResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
 type GetLikesBySubjectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SubjectUri    string                 `protobuf:"bytes,1,opt,name=subject_uri,json=subjectUri,proto3" json:"subject_uri,omitempty"`
@@ -353,7 +337,6 @@ func (x *GetLikesBySubjectRequest) GetCursor() string {
 
 type GetLikesBySubjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Likes         []*Like                `protobuf:"bytes,2,rep,name=likes,proto3" json:"likes,omitempty"`
 	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
@@ -391,13 +374,6 @@ func (*GetLikesBySubjectResponse) Descriptor() ([]byte, []int) {
 	return file_like_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetLikesBySubjectResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
-}
-
 func (x *GetLikesBySubjectResponse) GetLikes() []*Like {
 	if x != nil {
 		return x.Likes
@@ -419,6 +395,118 @@ func (x *GetLikesBySubjectResponse) GetCursor() string {
 	return ""
 }
 
+type GetLikesByActorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesByActorRequest) Reset() {
+	*x = GetLikesByActorRequest{}
+	mi := &file_like_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesByActorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesByActorRequest) ProtoMessage() {}
+
+func (x *GetLikesByActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesByActorRequest.ProtoReflect.Descriptor instead.
+func (*GetLikesByActorRequest) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLikesByActorRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *GetLikesByActorRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLikesByActorRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetLikesByActorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         []*Like                `protobuf:"bytes,2,rep,name=likes,proto3" json:"likes,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesByActorResponse) Reset() {
+	*x = GetLikesByActorResponse{}
+	mi := &file_like_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesByActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesByActorResponse) ProtoMessage() {}
+
+func (x *GetLikesByActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_like_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesByActorResponse.ProtoReflect.Descriptor instead.
+func (*GetLikesByActorResponse) Descriptor() ([]byte, []int) {
+	return file_like_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLikesByActorResponse) GetLikes() []*Like {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *GetLikesByActorResponse) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 var File_like_proto protoreflect.FileDescriptor
 
 const file_like_proto_rawDesc = "" +
@@ -439,34 +527,38 @@ const file_like_proto_rawDesc = "" +
 	"\n" +
 	"indexed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt\"<\n" +
 	"\x11CreateLikeRequest\x12'\n" +
-	"\x04like\x18\x01 \x01(\v2\x13.vyletdatabase.LikeR\x04like\"9\n" +
-	"\x12CreateLikeResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"-\n" +
+	"\x04like\x18\x01 \x01(\v2\x13.vyletdatabase.LikeR\x04like\"!\n" +
+	"\x12CreateLikeResponseJ\x04\b\x01\x10\x02R\x05error\"-\n" +
 	"\x11DeleteLikeRequest\x12\x18\n" +
-	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"9\n" +
-	"\x12DeleteLikeResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\x81\x01\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"!\n" +
+	"\x12DeleteLikeResponseJ\x04\b\x01\x10\x02R\x05error\"\x81\x01\n" +
 	"\x18GetLikesBySubjectRequest\x12'\n" +
 	"\vsubject_uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
 	"subjectUri\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x1b\n" +
 	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
-	"\a_cursor\"\xa9\x01\n" +
-	"\x19GetLikesBySubjectResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12)\n" +
+	"\a_cursor\"\x91\x01\n" +
+	"\x19GetLikesBySubjectResponse\x12)\n" +
 	"\x05likes\x18\x02 \x03(\v2\x13.vyletdatabase.LikeR\x05likes\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x1b\n" +
-	"\x06cursor\x18\x04 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
-	"\x06_errorB\t\n" +
-	"\a_cursor2\x9b\x02\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error\"p\n" +
+	"\x16GetLikesByActorRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"y\n" +
+	"\x17GetLikesByActorResponse\x12)\n" +
+	"\x05likes\x18\x02 \x03(\v2\x13.vyletdatabase.LikeR\x05likes\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error2\x87\x03\n" +
 	"\vLikeService\x12Q\n" +
 	"\n" +
 	"CreateLike\x12 .vyletdatabase.CreateLikeRequest\x1a!.vyletdatabase.CreateLikeResponse\x12Q\n" +
 	"\n" +
-	"DeleteLike\x12 .vyletdatabase.DeleteLikeRequest\x1a!.vyletdatabase.DeleteLikeResponse\x12f\n" +
-	"\x11GetLikesBySubject\x12'.vyletdatabase.GetLikesBySubjectRequest\x1a(.vyletdatabase.GetLikesBySubjectResponseB\x84\x01\n" +
+	"DeleteLike\x12 .vyletdatabase.DeleteLikeRequest\x1a!.vyletdatabase.DeleteLikeResponse\x12k\n" +
+	"\x11GetLikesBySubject\x12'.vyletdatabase.GetLikesBySubjectRequest\x1a(.vyletdatabase.GetLikesBySubjectResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x0fGetLikesByActor\x12%.vyletdatabase.GetLikesByActorRequest\x1a&.vyletdatabase.GetLikesByActorResponse\"\x03\x90\x02\x01B\x84\x01\n" +
 	"\x11com.vyletdatabaseB\tLikeProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
 
 var (
@@ -481,7 +573,7 @@ func file_like_proto_rawDescGZIP() []byte {
 	return file_like_proto_rawDescData
 }
 
-var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_like_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_like_proto_goTypes = []any{
 	(*Like)(nil),                      // 0: vyletdatabase.Like
 	(*CreateLikeRequest)(nil),         // 1: vyletdatabase.CreateLikeRequest
@@ -490,24 +582,29 @@ var file_like_proto_goTypes = []any{
 	(*DeleteLikeResponse)(nil),        // 4: vyletdatabase.DeleteLikeResponse
 	(*GetLikesBySubjectRequest)(nil),  // 5: vyletdatabase.GetLikesBySubjectRequest
 	(*GetLikesBySubjectResponse)(nil), // 6: vyletdatabase.GetLikesBySubjectResponse
-	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+	(*GetLikesByActorRequest)(nil),    // 7: vyletdatabase.GetLikesByActorRequest
+	(*GetLikesByActorResponse)(nil),   // 8: vyletdatabase.GetLikesByActorResponse
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
 }
 var file_like_proto_depIdxs = []int32{
-	7, // 0: vyletdatabase.Like.created_at:type_name -> google.protobuf.Timestamp
-	7, // 1: vyletdatabase.Like.indexed_at:type_name -> google.protobuf.Timestamp
+	9, // 0: vyletdatabase.Like.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: vyletdatabase.Like.indexed_at:type_name -> google.protobuf.Timestamp
 	0, // 2: vyletdatabase.CreateLikeRequest.like:type_name -> vyletdatabase.Like
 	0, // 3: vyletdatabase.GetLikesBySubjectResponse.likes:type_name -> vyletdatabase.Like
-	1, // 4: vyletdatabase.LikeService.CreateLike:input_type -> vyletdatabase.CreateLikeRequest
-	3, // 5: vyletdatabase.LikeService.DeleteLike:input_type -> vyletdatabase.DeleteLikeRequest
-	5, // 6: vyletdatabase.LikeService.GetLikesBySubject:input_type -> vyletdatabase.GetLikesBySubjectRequest
-	2, // 7: vyletdatabase.LikeService.CreateLike:output_type -> vyletdatabase.CreateLikeResponse
-	4, // 8: vyletdatabase.LikeService.DeleteLike:output_type -> vyletdatabase.DeleteLikeResponse
-	6, // 9: vyletdatabase.LikeService.GetLikesBySubject:output_type -> vyletdatabase.GetLikesBySubjectResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0, // 4: vyletdatabase.GetLikesByActorResponse.likes:type_name -> vyletdatabase.Like
+	1, // 5: vyletdatabase.LikeService.CreateLike:input_type -> vyletdatabase.CreateLikeRequest
+	3, // 6: vyletdatabase.LikeService.DeleteLike:input_type -> vyletdatabase.DeleteLikeRequest
+	5, // 7: vyletdatabase.LikeService.GetLikesBySubject:input_type -> vyletdatabase.GetLikesBySubjectRequest
+	7, // 8: vyletdatabase.LikeService.GetLikesByActor:input_type -> vyletdatabase.GetLikesByActorRequest
+	2, // 9: vyletdatabase.LikeService.CreateLike:output_type -> vyletdatabase.CreateLikeResponse
+	4, // 10: vyletdatabase.LikeService.DeleteLike:output_type -> vyletdatabase.DeleteLikeResponse
+	6, // 11: vyletdatabase.LikeService.GetLikesBySubject:output_type -> vyletdatabase.GetLikesBySubjectResponse
+	8, // 12: vyletdatabase.LikeService.GetLikesByActor:output_type -> vyletdatabase.GetLikesByActorResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_like_proto_init() }
@@ -515,17 +612,17 @@ func file_like_proto_init() {
 	if File_like_proto != nil {
 		return
 	}
-	file_like_proto_msgTypes[2].OneofWrappers = []any{}
-	file_like_proto_msgTypes[4].OneofWrappers = []any{}
 	file_like_proto_msgTypes[5].OneofWrappers = []any{}
 	file_like_proto_msgTypes[6].OneofWrappers = []any{}
+	file_like_proto_msgTypes[7].OneofWrappers = []any{}
+	file_like_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_like_proto_rawDesc), len(file_like_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	LikeService_CreateLike_FullMethodName        = "/vyletdatabase.LikeService/CreateLike"
 	LikeService_DeleteLike_FullMethodName        = "/vyletdatabase.LikeService/DeleteLike"
 	LikeService_GetLikesBySubject_FullMethodName = "/vyletdatabase.LikeService/GetLikesBySubject"
+	LikeService_GetLikesByActor_FullMethodName   = "/vyletdatabase.LikeService/GetLikesByActor"
 )
 
 // LikeServiceClient is the client API for LikeService service.
@@ -31,6 +32,7 @@ type LikeServiceClient interface {
 	CreateLike(ctx context.Context, in *CreateLikeRequest, opts ...grpc.CallOption) (*CreateLikeResponse, error)
 	DeleteLike(ctx context.Context, in *DeleteLikeRequest, opts ...grpc.CallOption) (*DeleteLikeResponse, error)
 	GetLikesBySubject(ctx context.Context, in *GetLikesBySubjectRequest, opts ...grpc.CallOption) (*GetLikesBySubjectResponse, error)
+	GetLikesByActor(ctx context.Context, in *GetLikesByActorRequest, opts ...grpc.CallOption) (*GetLikesByActorResponse, error)
 }
 
 type likeServiceClient struct {
@@ -71,6 +73,16 @@ func (c *likeServiceClient) GetLikesBySubject(ctx context.Context, in *GetLikesB
 	return out, nil
 }
 
+func (c *likeServiceClient) GetLikesByActor(ctx context.Context, in *GetLikesByActorRequest, opts ...grpc.CallOption) (*GetLikesByActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLikesByActorResponse)
+	err := c.cc.Invoke(ctx, LikeService_GetLikesByActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LikeServiceServer is the server API for LikeService service.
 // All implementations must embed UnimplementedLikeServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type LikeServiceServer interface {
 	CreateLike(context.Context, *CreateLikeRequest) (*CreateLikeResponse, error)
 	DeleteLike(context.Context, *DeleteLikeRequest) (*DeleteLikeResponse, error)
 	GetLikesBySubject(context.Context, *GetLikesBySubjectRequest) (*GetLikesBySubjectResponse, error)
+	GetLikesByActor(context.Context, *GetLikesByActorRequest) (*GetLikesByActorResponse, error)
 	mustEmbedUnimplementedLikeServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedLikeServiceServer) DeleteLike(context.Context, *DeleteLikeReq
 func (UnimplementedLikeServiceServer) GetLikesBySubject(context.Context, *GetLikesBySubjectRequest) (*GetLikesBySubjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLikesBySubject not implemented")
 }
+func (UnimplementedLikeServiceServer) GetLikesByActor(context.Context, *GetLikesByActorRequest) (*GetLikesByActorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLikesByActor not implemented")
+}
 func (UnimplementedLikeServiceServer) mustEmbedUnimplementedLikeServiceServer() {}
 func (UnimplementedLikeServiceServer) testEmbeddedByValue()                     {}
 
@@ -172,6 +188,24 @@ func _LikeService_GetLikesBySubject_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LikeService_GetLikesByActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikesByActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LikeServiceServer).GetLikesByActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LikeService_GetLikesByActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LikeServiceServer).GetLikesByActor(ctx, req.(*GetLikesByActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LikeService_ServiceDesc is the grpc.ServiceDesc for LikeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var LikeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLikesBySubject",
 			Handler:    _LikeService_GetLikesBySubject_Handler,
 		},
+		{
+			MethodName: "GetLikesByActor",
+			Handler:    _LikeService_GetLikesByActor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "like.proto",
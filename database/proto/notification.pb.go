@@ -0,0 +1,787 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification.proto
+
+package vyletdatabase
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationReason int32
+
+const (
+	NotificationReason_NOTIFICATION_REASON_UNSPECIFIED NotificationReason = 0
+	NotificationReason_NOTIFICATION_REASON_LIKE        NotificationReason = 1
+	NotificationReason_NOTIFICATION_REASON_FOLLOW      NotificationReason = 2
+	NotificationReason_NOTIFICATION_REASON_COMMENT     NotificationReason = 3
+	NotificationReason_NOTIFICATION_REASON_MENTION     NotificationReason = 4
+)
+
+// Enum value maps for NotificationReason.
+var (
+	NotificationReason_name = map[int32]string{
+		0: "NOTIFICATION_REASON_UNSPECIFIED",
+		1: "NOTIFICATION_REASON_LIKE",
+		2: "NOTIFICATION_REASON_FOLLOW",
+		3: "NOTIFICATION_REASON_COMMENT",
+		4: "NOTIFICATION_REASON_MENTION",
+	}
+	NotificationReason_value = map[string]int32{
+		"NOTIFICATION_REASON_UNSPECIFIED": 0,
+		"NOTIFICATION_REASON_LIKE":        1,
+		"NOTIFICATION_REASON_FOLLOW":      2,
+		"NOTIFICATION_REASON_COMMENT":     3,
+		"NOTIFICATION_REASON_MENTION":     4,
+	}
+)
+
+func (x NotificationReason) Enum() *NotificationReason {
+	p := new(NotificationReason)
+	*p = x
+	return p
+}
+
+func (x NotificationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationReason) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[0]
+}
+
+func (x NotificationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationReason.Descriptor instead.
+func (NotificationReason) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+type Notification struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RecipientDid string                 `protobuf:"bytes,1,opt,name=recipient_did,json=recipientDid,proto3" json:"recipient_did,omitempty"`
+	// The record that caused the notification
+	Uri       string             `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cid       string             `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	AuthorDid string             `protobuf:"bytes,4,opt,name=author_did,json=authorDid,proto3" json:"author_did,omitempty"`
+	Reason    NotificationReason `protobuf:"varint,5,opt,name=reason,proto3,enum=vyletdatabase.NotificationReason" json:"reason,omitempty"`
+	// The recipient's record that the notification is about, if any
+	ReasonSubject *string                `protobuf:"bytes,6,opt,name=reason_subject,json=reasonSubject,proto3,oneof" json:"reason_subject,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IndexedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	// Whether the notification was created at or before the recipient's seen at time. Only set when reading.
+	IsRead        bool `protobuf:"varint,9,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetRecipientDid() string {
+	if x != nil {
+		return x.RecipientDid
+	}
+	return ""
+}
+
+func (x *Notification) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Notification) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *Notification) GetAuthorDid() string {
+	if x != nil {
+		return x.AuthorDid
+	}
+	return ""
+}
+
+func (x *Notification) GetReason() NotificationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return NotificationReason_NOTIFICATION_REASON_UNSPECIFIED
+}
+
+func (x *Notification) GetReasonSubject() string {
+	if x != nil && x.ReasonSubject != nil {
+		return *x.ReasonSubject
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+type CreateNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationsRequest) Reset() {
+	*x = CreateNotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationsRequest) ProtoMessage() {}
+
+func (x *CreateNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateNotificationsRequest) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type CreateNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationsResponse) Reset() {
+	*x = CreateNotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationsResponse) ProtoMessage() {}
+
+func (x *CreateNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteNotificationsByUriRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationsByUriRequest) Reset() {
+	*x = DeleteNotificationsByUriRequest{}
+	mi := &file_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationsByUriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationsByUriRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationsByUriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationsByUriRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationsByUriRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteNotificationsByUriRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type DeleteNotificationsByUriResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationsByUriResponse) Reset() {
+	*x = DeleteNotificationsByUriResponse{}
+	mi := &file_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationsByUriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationsByUriResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationsByUriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationsByUriResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationsByUriResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{4}
+}
+
+type GetNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNotificationsRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	SeenAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=seen_at,json=seenAt,proto3,oneof" json:"seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResponse) ProtoMessage() {}
+
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResponse) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetNotificationsResponse) GetSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
+type GetUnreadNotificationCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationCountRequest) Reset() {
+	*x = GetUnreadNotificationCountRequest{}
+	mi := &file_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUnreadNotificationCountRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+type GetUnreadNotificationCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationCountResponse) Reset() {
+	*x = GetUnreadNotificationCountResponse{}
+	mi := &file_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUnreadNotificationCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UpdateNotificationsSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	SeenAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationsSeenRequest) Reset() {
+	*x = UpdateNotificationsSeenRequest{}
+	mi := &file_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationsSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationsSeenRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationsSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationsSeenRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationsSeenRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateNotificationsSeenRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *UpdateNotificationsSeenRequest) GetSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
+type UpdateNotificationsSeenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationsSeenResponse) Reset() {
+	*x = UpdateNotificationsSeenResponse{}
+	mi := &file_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationsSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationsSeenResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationsSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationsSeenResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationsSeenResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{10}
+}
+
+var File_notification_proto protoreflect.FileDescriptor
+
+const file_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x12notification.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x03\n" +
+	"\fNotification\x12+\n" +
+	"\rrecipient_did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\frecipientDid\x12\x18\n" +
+	"\x03uri\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\x12\x18\n" +
+	"\x03cid\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12%\n" +
+	"\n" +
+	"author_did\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tauthorDid\x12A\n" +
+	"\x06reason\x18\x05 \x01(\x0e2!.vyletdatabase.NotificationReasonB\x06\xbaH\x03\xc8\x01\x01R\x06reason\x12*\n" +
+	"\x0ereason_subject\x18\x06 \x01(\tH\x00R\rreasonSubject\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"indexed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt\x12\x17\n" +
+	"\ais_read\x18\t \x01(\bR\x06isReadB\x11\n" +
+	"\x0f_reason_subject\"_\n" +
+	"\x1aCreateNotificationsRequest\x12A\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1b.vyletdatabase.NotificationR\rnotifications\"*\n" +
+	"\x1bCreateNotificationsResponseJ\x04\b\x01\x10\x02R\x05error\";\n" +
+	"\x1fDeleteNotificationsByUriRequest\x12\x18\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"/\n" +
+	" DeleteNotificationsByUriResponseJ\x04\b\x01\x10\x02R\x05error\"y\n" +
+	"\x17GetNotificationsRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\xd8\x01\n" +
+	"\x18GetNotificationsResponse\x12A\n" +
+	"\rnotifications\x18\x02 \x03(\v2\x1b.vyletdatabase.NotificationR\rnotifications\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01\x128\n" +
+	"\aseen_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06seenAt\x88\x01\x01B\t\n" +
+	"\a_cursorB\n" +
+	"\n" +
+	"\b_seen_atJ\x04\b\x01\x10\x02R\x05error\"=\n" +
+	"!GetUnreadNotificationCountRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"G\n" +
+	"\"GetUnreadNotificationCountResponse\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05countJ\x04\b\x01\x10\x02R\x05error\"w\n" +
+	"\x1eUpdateNotificationsSeenRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12;\n" +
+	"\aseen_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06seenAt\".\n" +
+	"\x1fUpdateNotificationsSeenResponseJ\x04\b\x01\x10\x02R\x05error*\xb9\x01\n" +
+	"\x12NotificationReason\x12#\n" +
+	"\x1fNOTIFICATION_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18NOTIFICATION_REASON_LIKE\x10\x01\x12\x1e\n" +
+	"\x1aNOTIFICATION_REASON_FOLLOW\x10\x02\x12\x1f\n" +
+	"\x1bNOTIFICATION_REASON_COMMENT\x10\x03\x12\x1f\n" +
+	"\x1bNOTIFICATION_REASON_MENTION\x10\x042\xed\x04\n" +
+	"\x13NotificationService\x12l\n" +
+	"\x13CreateNotifications\x12).vyletdatabase.CreateNotificationsRequest\x1a*.vyletdatabase.CreateNotificationsResponse\x12{\n" +
+	"\x18DeleteNotificationsByUri\x12..vyletdatabase.DeleteNotificationsByUriRequest\x1a/.vyletdatabase.DeleteNotificationsByUriResponse\x12h\n" +
+	"\x10GetNotifications\x12&.vyletdatabase.GetNotificationsRequest\x1a'.vyletdatabase.GetNotificationsResponse\"\x03\x90\x02\x01\x12\x86\x01\n" +
+	"\x1aGetUnreadNotificationCount\x120.vyletdatabase.GetUnreadNotificationCountRequest\x1a1.vyletdatabase.GetUnreadNotificationCountResponse\"\x03\x90\x02\x01\x12x\n" +
+	"\x17UpdateNotificationsSeen\x12-.vyletdatabase.UpdateNotificationsSeenRequest\x1a..vyletdatabase.UpdateNotificationsSeenResponseB\x8c\x01\n" +
+	"\x11com.vyletdatabaseB\x11NotificationProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
+
+var (
+	file_notification_proto_rawDescOnce sync.Once
+	file_notification_proto_rawDescData []byte
+)
+
+func file_notification_proto_rawDescGZIP() []byte {
+	file_notification_proto_rawDescOnce.Do(func() {
+		file_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)))
+	})
+	return file_notification_proto_rawDescData
+}
+
+var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_notification_proto_goTypes = []any{
+	(NotificationReason)(0),                    // 0: vyletdatabase.NotificationReason
+	(*Notification)(nil),                       // 1: vyletdatabase.Notification
+	(*CreateNotificationsRequest)(nil),         // 2: vyletdatabase.CreateNotificationsRequest
+	(*CreateNotificationsResponse)(nil),        // 3: vyletdatabase.CreateNotificationsResponse
+	(*DeleteNotificationsByUriRequest)(nil),    // 4: vyletdatabase.DeleteNotificationsByUriRequest
+	(*DeleteNotificationsByUriResponse)(nil),   // 5: vyletdatabase.DeleteNotificationsByUriResponse
+	(*GetNotificationsRequest)(nil),            // 6: vyletdatabase.GetNotificationsRequest
+	(*GetNotificationsResponse)(nil),           // 7: vyletdatabase.GetNotificationsResponse
+	(*GetUnreadNotificationCountRequest)(nil),  // 8: vyletdatabase.GetUnreadNotificationCountRequest
+	(*GetUnreadNotificationCountResponse)(nil), // 9: vyletdatabase.GetUnreadNotificationCountResponse
+	(*UpdateNotificationsSeenRequest)(nil),     // 10: vyletdatabase.UpdateNotificationsSeenRequest
+	(*UpdateNotificationsSeenResponse)(nil),    // 11: vyletdatabase.UpdateNotificationsSeenResponse
+	(*timestamppb.Timestamp)(nil),              // 12: google.protobuf.Timestamp
+}
+var file_notification_proto_depIdxs = []int32{
+	0,  // 0: vyletdatabase.Notification.reason:type_name -> vyletdatabase.NotificationReason
+	12, // 1: vyletdatabase.Notification.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: vyletdatabase.Notification.indexed_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: vyletdatabase.CreateNotificationsRequest.notifications:type_name -> vyletdatabase.Notification
+	1,  // 4: vyletdatabase.GetNotificationsResponse.notifications:type_name -> vyletdatabase.Notification
+	12, // 5: vyletdatabase.GetNotificationsResponse.seen_at:type_name -> google.protobuf.Timestamp
+	12, // 6: vyletdatabase.UpdateNotificationsSeenRequest.seen_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: vyletdatabase.NotificationService.CreateNotifications:input_type -> vyletdatabase.CreateNotificationsRequest
+	4,  // 8: vyletdatabase.NotificationService.DeleteNotificationsByUri:input_type -> vyletdatabase.DeleteNotificationsByUriRequest
+	6,  // 9: vyletdatabase.NotificationService.GetNotifications:input_type -> vyletdatabase.GetNotificationsRequest
+	8,  // 10: vyletdatabase.NotificationService.GetUnreadNotificationCount:input_type -> vyletdatabase.GetUnreadNotificationCountRequest
+	10, // 11: vyletdatabase.NotificationService.UpdateNotificationsSeen:input_type -> vyletdatabase.UpdateNotificationsSeenRequest
+	3,  // 12: vyletdatabase.NotificationService.CreateNotifications:output_type -> vyletdatabase.CreateNotificationsResponse
+	5,  // 13: vyletdatabase.NotificationService.DeleteNotificationsByUri:output_type -> vyletdatabase.DeleteNotificationsByUriResponse
+	7,  // 14: vyletdatabase.NotificationService.GetNotifications:output_type -> vyletdatabase.GetNotificationsResponse
+	9,  // 15: vyletdatabase.NotificationService.GetUnreadNotificationCount:output_type -> vyletdatabase.GetUnreadNotificationCountResponse
+	11, // 16: vyletdatabase.NotificationService.UpdateNotificationsSeen:output_type -> vyletdatabase.UpdateNotificationsSeenResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_notification_proto_init() }
+func file_notification_proto_init() {
+	if File_notification_proto != nil {
+		return
+	}
+	file_notification_proto_msgTypes[0].OneofWrappers = []any{}
+	file_notification_proto_msgTypes[5].OneofWrappers = []any{}
+	file_notification_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_proto_goTypes,
+		DependencyIndexes: file_notification_proto_depIdxs,
+		EnumInfos:         file_notification_proto_enumTypes,
+		MessageInfos:      file_notification_proto_msgTypes,
+	}.Build()
+	File_notification_proto = out.File
+	file_notification_proto_goTypes = nil
+	file_notification_proto_depIdxs = nil
+}
@@ -107,23 +107,193 @@ func (x *Image) GetMime() string {
 	return ""
 }
 
-type Post struct {
+// A rich text annotation of a byte range of a post's caption. Facets are validated and normalized by the
+// indexer before being stored, so byte ranges are always within the caption and on UTF-8 boundaries.
+type Facet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Cid           string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	AuthorDid     string                 `protobuf:"bytes,3,opt,name=author_did,json=authorDid,proto3" json:"author_did,omitempty"`
-	Images        []*Image               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
-	Caption       *string                `protobuf:"bytes,5,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
-	Facets        []byte                 `protobuf:"bytes,6,opt,name=facets,proto3,oneof" json:"facets,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IndexedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	ByteStart     int64                  `protobuf:"varint,1,opt,name=byte_start,json=byteStart,proto3" json:"byte_start,omitempty"`
+	ByteEnd       int64                  `protobuf:"varint,2,opt,name=byte_end,json=byteEnd,proto3" json:"byte_end,omitempty"`
+	Features      []*FacetFeature        `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_post_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Facet) GetByteStart() int64 {
+	if x != nil {
+		return x.ByteStart
+	}
+	return 0
+}
+
+func (x *Facet) GetByteEnd() int64 {
+	if x != nil {
+		return x.ByteEnd
+	}
+	return 0
+}
+
+func (x *Facet) GetFeatures() []*FacetFeature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type FacetFeature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Feature:
+	//
+	//	*FacetFeature_Mention
+	//	*FacetFeature_Link
+	//	*FacetFeature_Tag
+	Feature       isFacetFeature_Feature `protobuf_oneof:"feature"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetFeature) Reset() {
+	*x = FacetFeature{}
+	mi := &file_post_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetFeature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetFeature) ProtoMessage() {}
+
+func (x *FacetFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetFeature.ProtoReflect.Descriptor instead.
+func (*FacetFeature) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FacetFeature) GetFeature() isFacetFeature_Feature {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+func (x *FacetFeature) GetMention() string {
+	if x != nil {
+		if x, ok := x.Feature.(*FacetFeature_Mention); ok {
+			return x.Mention
+		}
+	}
+	return ""
+}
+
+func (x *FacetFeature) GetLink() string {
+	if x != nil {
+		if x, ok := x.Feature.(*FacetFeature_Link); ok {
+			return x.Link
+		}
+	}
+	return ""
+}
+
+func (x *FacetFeature) GetTag() string {
+	if x != nil {
+		if x, ok := x.Feature.(*FacetFeature_Tag); ok {
+			return x.Tag
+		}
+	}
+	return ""
+}
+
+type isFacetFeature_Feature interface {
+	isFacetFeature_Feature()
+}
+
+type FacetFeature_Mention struct {
+	// DID of the mentioned account
+	Mention string `protobuf:"bytes,1,opt,name=mention,proto3,oneof"`
+}
+
+type FacetFeature_Link struct {
+	// Normalized URL of a link
+	Link string `protobuf:"bytes,2,opt,name=link,proto3,oneof"`
+}
+
+type FacetFeature_Tag struct {
+	// Normalized hashtag, without the leading '#'
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3,oneof"`
+}
+
+func (*FacetFeature_Mention) isFacetFeature_Feature() {}
+
+func (*FacetFeature_Link) isFacetFeature_Feature() {}
+
+func (*FacetFeature_Tag) isFacetFeature_Feature() {}
+
+type Post struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cid       string                 `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	AuthorDid string                 `protobuf:"bytes,3,opt,name=author_did,json=authorDid,proto3" json:"author_did,omitempty"`
+	Images    []*Image               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	Caption   *string                `protobuf:"bytes,5,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	// JSON encoded facets of posts that were indexed before facets were stored in a typed form. Only set when
+	// facets is empty.
+	//
+	// Deprecated: Marked as deprecated in post.proto.
+	LegacyFacets []byte                 `protobuf:"bytes,6,opt,name=legacy_facets,json=legacyFacets,proto3,oneof" json:"legacy_facets,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IndexedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	Facets       []*Facet               `protobuf:"bytes,9,rep,name=facets,proto3" json:"facets,omitempty"`
+	// Normalized hashtags used by the post, from both its facets and caption
+	Tags          []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
-	mi := &file_post_proto_msgTypes[1]
+	mi := &file_post_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +305,7 @@ func (x *Post) String() string {
 func (*Post) ProtoMessage() {}
 
 func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[1]
+	mi := &file_post_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +318,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{1}
+	return file_post_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Post) GetUri() string {
@@ -186,9 +356,10 @@ func (x *Post) GetCaption() string {
 	return ""
 }
 
-func (x *Post) GetFacets() []byte {
+// Deprecated: Marked as deprecated in post.proto.
+func (x *Post) GetLegacyFacets() []byte {
 	if x != nil {
-		return x.Facets
+		return x.LegacyFacets
 	}
 	return nil
 }
@@ -207,6 +378,20 @@ func (x *Post) GetIndexedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Post) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreatePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
@@ -216,7 +401,7 @@ type CreatePostRequest struct {
 
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_post_proto_msgTypes[2]
+	mi := &file_post_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +413,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[2]
+	mi := &file_post_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +426,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{2}
+	return file_post_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreatePostRequest) GetPost() *Post {
@@ -253,14 +438,13 @@ func (x *CreatePostRequest) GetPost() *Post {
 
 type CreatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePostResponse) Reset() {
 	*x = CreatePostResponse{}
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +456,7 @@ func (x *CreatePostResponse) String() string {
 func (*CreatePostResponse) ProtoMessage() {}
 
 func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[3]
+	mi := &file_post_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,14 +469,7 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CreatePostResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{5}
 }
 
 type DeletePostRequest struct {
@@ -304,7 +481,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +493,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[4]
+	mi := &file_post_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +506,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{4}
+	return file_post_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeletePostRequest) GetUri() string {
@@ -341,14 +518,13 @@ func (x *DeletePostRequest) GetUri() string {
 
 type DeletePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeletePostResponse) Reset() {
 	*x = DeletePostResponse{}
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +536,7 @@ func (x *DeletePostResponse) String() string {
 func (*DeletePostResponse) ProtoMessage() {}
 
 func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[5]
+	mi := &file_post_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,14 +549,7 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
 func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *DeletePostResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{7}
 }
 
 type GetPostsRequest struct {
@@ -392,7 +561,7 @@ type GetPostsRequest struct {
 
 func (x *GetPostsRequest) Reset() {
 	*x = GetPostsRequest{}
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +573,7 @@ func (x *GetPostsRequest) String() string {
 func (*GetPostsRequest) ProtoMessage() {}
 
 func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[6]
+	mi := &file_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +586,7 @@ func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
 func (*GetPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{6}
+	return file_post_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPostsRequest) GetUris() []string {
@@ -429,7 +598,6 @@ func (x *GetPostsRequest) GetUris() []string {
 
 type GetPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Posts         map[string]*Post       `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -437,7 +605,7 @@ type GetPostsResponse struct {
 
 func (x *GetPostsResponse) Reset() {
 	*x = GetPostsResponse{}
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +617,7 @@ func (x *GetPostsResponse) String() string {
 func (*GetPostsResponse) ProtoMessage() {}
 
 func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[7]
+	mi := &file_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,14 +630,7 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
 func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetPostsResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPostsResponse) GetPosts() map[string]*Post {
@@ -490,7 +651,7 @@ type GetPostsByActorRequest struct {
 
 func (x *GetPostsByActorRequest) Reset() {
 	*x = GetPostsByActorRequest{}
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +663,7 @@ func (x *GetPostsByActorRequest) String() string {
 func (*GetPostsByActorRequest) ProtoMessage() {}
 
 func (x *GetPostsByActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[8]
+	mi := &file_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +676,7 @@ func (x *GetPostsByActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostsByActorRequest.ProtoReflect.Descriptor instead.
 func (*GetPostsByActorRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{8}
+	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPostsByActorRequest) GetDid() string {
@@ -541,7 +702,6 @@ func (x *GetPostsByActorRequest) GetCursor() string {
 
 type GetPostsByActorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Posts         map[string]*Post       `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -550,7 +710,7 @@ type GetPostsByActorResponse struct {
 
 func (x *GetPostsByActorResponse) Reset() {
 	*x = GetPostsByActorResponse{}
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +722,7 @@ func (x *GetPostsByActorResponse) String() string {
 func (*GetPostsByActorResponse) ProtoMessage() {}
 
 func (x *GetPostsByActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[9]
+	mi := &file_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,14 +735,7 @@ func (x *GetPostsByActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostsByActorResponse.ProtoReflect.Descriptor instead.
 func (*GetPostsByActorResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *GetPostsByActorResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetPostsByActorResponse) GetPosts() map[string]*Post {
@@ -608,7 +761,7 @@ type GetPostInteractionCountsRequest struct {
 
 func (x *GetPostInteractionCountsRequest) Reset() {
 	*x = GetPostInteractionCountsRequest{}
-	mi := &file_post_proto_msgTypes[10]
+	mi := &file_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +773,7 @@ func (x *GetPostInteractionCountsRequest) String() string {
 func (*GetPostInteractionCountsRequest) ProtoMessage() {}
 
 func (x *GetPostInteractionCountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[10]
+	mi := &file_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +786,7 @@ func (x *GetPostInteractionCountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostInteractionCountsRequest.ProtoReflect.Descriptor instead.
 func (*GetPostInteractionCountsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{10}
+	return file_post_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPostInteractionCountsRequest) GetUri() string {
@@ -653,7 +806,7 @@ type PostInteractionCounts struct {
 
 func (x *PostInteractionCounts) Reset() {
 	*x = PostInteractionCounts{}
-	mi := &file_post_proto_msgTypes[11]
+	mi := &file_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +818,7 @@ func (x *PostInteractionCounts) String() string {
 func (*PostInteractionCounts) ProtoMessage() {}
 
 func (x *PostInteractionCounts) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[11]
+	mi := &file_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +831,7 @@ func (x *PostInteractionCounts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostInteractionCounts.ProtoReflect.Descriptor instead.
 func (*PostInteractionCounts) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{11}
+	return file_post_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PostInteractionCounts) GetLikes() int64 {
@@ -697,7 +850,6 @@ func (x *PostInteractionCounts) GetReplies() int64 {
 
 type GetPostInteractionCountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Counts        *PostInteractionCounts `protobuf:"bytes,2,opt,name=counts,proto3,oneof" json:"counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -705,7 +857,7 @@ type GetPostInteractionCountsResponse struct {
 
 func (x *GetPostInteractionCountsResponse) Reset() {
 	*x = GetPostInteractionCountsResponse{}
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +869,7 @@ func (x *GetPostInteractionCountsResponse) String() string {
 func (*GetPostInteractionCountsResponse) ProtoMessage() {}
 
 func (x *GetPostInteractionCountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,14 +882,7 @@ func (x *GetPostInteractionCountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostInteractionCountsResponse.ProtoReflect.Descriptor instead.
 func (*GetPostInteractionCountsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetPostInteractionCountsResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPostInteractionCountsResponse) GetCounts() *PostInteractionCounts {
@@ -756,7 +901,7 @@ type GetPostsInteractionCountsRequest struct {
 
 func (x *GetPostsInteractionCountsRequest) Reset() {
 	*x = GetPostsInteractionCountsRequest{}
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +913,7 @@ func (x *GetPostsInteractionCountsRequest) String() string {
 func (*GetPostsInteractionCountsRequest) ProtoMessage() {}
 
 func (x *GetPostsInteractionCountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +926,7 @@ func (x *GetPostsInteractionCountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostsInteractionCountsRequest.ProtoReflect.Descriptor instead.
 func (*GetPostsInteractionCountsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+	return file_post_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPostsInteractionCountsRequest) GetUris() []string {
@@ -793,7 +938,6 @@ func (x *GetPostsInteractionCountsRequest) GetUris() []string {
 
 type GetPostsInteractionCountsResponse struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Error         *string                           `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Counts        map[string]*PostInteractionCounts `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -801,7 +945,7 @@ type GetPostsInteractionCountsResponse struct {
 
 func (x *GetPostsInteractionCountsResponse) Reset() {
 	*x = GetPostsInteractionCountsResponse{}
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +957,7 @@ func (x *GetPostsInteractionCountsResponse) String() string {
 func (*GetPostsInteractionCountsResponse) ProtoMessage() {}
 
 func (x *GetPostsInteractionCountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,14 +970,7 @@ func (x *GetPostsInteractionCountsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetPostsInteractionCountsResponse.ProtoReflect.Descriptor instead.
 func (*GetPostsInteractionCountsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetPostsInteractionCountsResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_post_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetPostsInteractionCountsResponse) GetCounts() map[string]*PostInteractionCounts {
@@ -858,85 +995,86 @@ const file_post_proto_rawDesc = "" +
 	"\x04mime\x18\x06 \x01(\tR\x04mimeB\x06\n" +
 	"\x04_altB\b\n" +
 	"\x06_widthB\t\n" +
-	"\a_height\"\xd8\x02\n" +
+	"\a_height\"z\n" +
+	"\x05Facet\x12\x1d\n" +
+	"\n" +
+	"byte_start\x18\x01 \x01(\x03R\tbyteStart\x12\x19\n" +
+	"\bbyte_end\x18\x02 \x01(\x03R\abyteEnd\x127\n" +
+	"\bfeatures\x18\x03 \x03(\v2\x1b.vyletdatabase.FacetFeatureR\bfeatures\"_\n" +
+	"\fFacetFeature\x12\x1a\n" +
+	"\amention\x18\x01 \x01(\tH\x00R\amention\x12\x14\n" +
+	"\x04link\x18\x02 \x01(\tH\x00R\x04link\x12\x12\n" +
+	"\x03tag\x18\x03 \x01(\tH\x00R\x03tagB\t\n" +
+	"\afeature\"\xb2\x03\n" +
 	"\x04Post\x12\x18\n" +
 	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\x12\x18\n" +
 	"\x03cid\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03cid\x12%\n" +
 	"\n" +
 	"author_did\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tauthorDid\x12,\n" +
 	"\x06images\x18\x04 \x03(\v2\x14.vyletdatabase.ImageR\x06images\x12\x1d\n" +
-	"\acaption\x18\x05 \x01(\tH\x00R\acaption\x88\x01\x01\x12\x1b\n" +
-	"\x06facets\x18\x06 \x01(\fH\x01R\x06facets\x88\x01\x01\x129\n" +
+	"\acaption\x18\x05 \x01(\tH\x00R\acaption\x88\x01\x01\x12,\n" +
+	"\rlegacy_facets\x18\x06 \x01(\fB\x02\x18\x01H\x01R\flegacyFacets\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"indexed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAtB\n" +
+	"indexed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tindexedAt\x12,\n" +
+	"\x06facets\x18\t \x03(\v2\x14.vyletdatabase.FacetR\x06facets\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tagsB\n" +
 	"\n" +
-	"\b_captionB\t\n" +
-	"\a_facets\"<\n" +
+	"\b_captionB\x10\n" +
+	"\x0e_legacy_facets\"<\n" +
 	"\x11CreatePostRequest\x12'\n" +
-	"\x04post\x18\x01 \x01(\v2\x13.vyletdatabase.PostR\x04post\"9\n" +
-	"\x12CreatePostResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"-\n" +
+	"\x04post\x18\x01 \x01(\v2\x13.vyletdatabase.PostR\x04post\"!\n" +
+	"\x12CreatePostResponseJ\x04\b\x01\x10\x02R\x05error\"-\n" +
 	"\x11DeletePostRequest\x12\x18\n" +
-	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"9\n" +
-	"\x12DeletePostResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"-\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"!\n" +
+	"\x12DeletePostResponseJ\x04\b\x01\x10\x02R\x05error\"-\n" +
 	"\x0fGetPostsRequest\x12\x1a\n" +
-	"\x04uris\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04uris\"\xc8\x01\n" +
-	"\x10GetPostsResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12@\n" +
+	"\x04uris\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04uris\"\xb0\x01\n" +
+	"\x10GetPostsResponse\x12@\n" +
 	"\x05posts\x18\x02 \x03(\v2*.vyletdatabase.GetPostsResponse.PostsEntryR\x05posts\x1aM\n" +
 	"\n" +
 	"PostsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.vyletdatabase.PostR\x05value:\x028\x01B\b\n" +
-	"\x06_error\"x\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.vyletdatabase.PostR\x05value:\x028\x01J\x04\b\x01\x10\x02R\x05error\"x\n" +
 	"\x16GetPostsByActorRequest\x12\x18\n" +
 	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x1c\n" +
 	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\x12\x1b\n" +
 	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
-	"\a_cursor\"\xfe\x01\n" +
-	"\x17GetPostsByActorResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12G\n" +
+	"\a_cursor\"\xe6\x01\n" +
+	"\x17GetPostsByActorResponse\x12G\n" +
 	"\x05posts\x18\x02 \x03(\v21.vyletdatabase.GetPostsByActorResponse.PostsEntryR\x05posts\x12\x1b\n" +
-	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01\x1aM\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01\x1aM\n" +
 	"\n" +
 	"PostsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.vyletdatabase.PostR\x05value:\x028\x01B\b\n" +
-	"\x06_errorB\t\n" +
-	"\a_cursor\";\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.vyletdatabase.PostR\x05value:\x028\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error\";\n" +
 	"\x1fGetPostInteractionCountsRequest\x12\x18\n" +
 	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"W\n" +
 	"\x15PostInteractionCounts\x12\x1c\n" +
 	"\x05likes\x18\x01 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05likes\x12 \n" +
-	"\areplies\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\areplies\"\x95\x01\n" +
-	" GetPostInteractionCountsResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12A\n" +
-	"\x06counts\x18\x02 \x01(\v2$.vyletdatabase.PostInteractionCountsH\x01R\x06counts\x88\x01\x01B\b\n" +
-	"\x06_errorB\t\n" +
-	"\a_counts\">\n" +
+	"\areplies\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\areplies\"}\n" +
+	" GetPostInteractionCountsResponse\x12A\n" +
+	"\x06counts\x18\x02 \x01(\v2$.vyletdatabase.PostInteractionCountsH\x00R\x06counts\x88\x01\x01B\t\n" +
+	"\a_countsJ\x04\b\x01\x10\x02R\x05error\">\n" +
 	" GetPostsInteractionCountsRequest\x12\x1a\n" +
-	"\x04uris\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04uris\"\xff\x01\n" +
-	"!GetPostsInteractionCountsResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12T\n" +
+	"\x04uris\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04uris\"\xe7\x01\n" +
+	"!GetPostsInteractionCountsResponse\x12T\n" +
 	"\x06counts\x18\x02 \x03(\v2<.vyletdatabase.GetPostsInteractionCountsResponse.CountsEntryR\x06counts\x1a_\n" +
 	"\vCountsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
-	"\x05value\x18\x02 \x01(\v2$.vyletdatabase.PostInteractionCountsR\x05value:\x028\x01B\b\n" +
-	"\x06_error2\xdf\x04\n" +
+	"\x05value\x18\x02 \x01(\v2$.vyletdatabase.PostInteractionCountsR\x05value:\x028\x01J\x04\b\x01\x10\x02R\x05error2\xf5\x04\n" +
 	"\vPostService\x12Q\n" +
 	"\n" +
 	"CreatePost\x12 .vyletdatabase.CreatePostRequest\x1a!.vyletdatabase.CreatePostResponse\x12Q\n" +
 	"\n" +
-	"DeletePost\x12 .vyletdatabase.DeletePostRequest\x1a!.vyletdatabase.DeletePostResponse\x12K\n" +
-	"\bGetPosts\x12\x1e.vyletdatabase.GetPostsRequest\x1a\x1f.vyletdatabase.GetPostsResponse\x12`\n" +
-	"\x0fGetPostsByActor\x12%.vyletdatabase.GetPostsByActorRequest\x1a&.vyletdatabase.GetPostsByActorResponse\x12{\n" +
-	"\x18GetPostInteractionCounts\x12..vyletdatabase.GetPostInteractionCountsRequest\x1a/.vyletdatabase.GetPostInteractionCountsResponse\x12~\n" +
-	"\x19GetPostsInteractionCounts\x12/.vyletdatabase.GetPostsInteractionCountsRequest\x1a0.vyletdatabase.GetPostsInteractionCountsResponseB\x84\x01\n" +
+	"DeletePost\x12 .vyletdatabase.DeletePostRequest\x1a!.vyletdatabase.DeletePostResponse\x12P\n" +
+	"\bGetPosts\x12\x1e.vyletdatabase.GetPostsRequest\x1a\x1f.vyletdatabase.GetPostsResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x0fGetPostsByActor\x12%.vyletdatabase.GetPostsByActorRequest\x1a&.vyletdatabase.GetPostsByActorResponse\"\x03\x90\x02\x01\x12\x80\x01\n" +
+	"\x18GetPostInteractionCounts\x12..vyletdatabase.GetPostInteractionCountsRequest\x1a/.vyletdatabase.GetPostInteractionCountsResponse\"\x03\x90\x02\x01\x12\x83\x01\n" +
+	"\x19GetPostsInteractionCounts\x12/.vyletdatabase.GetPostsInteractionCountsRequest\x1a0.vyletdatabase.GetPostsInteractionCountsResponse\"\x03\x90\x02\x01B\x84\x01\n" +
 	"\x11com.vyletdatabaseB\tPostProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
 
 var (
@@ -951,57 +1089,61 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_post_proto_goTypes = []any{
 	(*Image)(nil),                             // 0: vyletdatabase.Image
-	(*Post)(nil),                              // 1: vyletdatabase.Post
-	(*CreatePostRequest)(nil),                 // 2: vyletdatabase.CreatePostRequest
-	(*CreatePostResponse)(nil),                // 3: vyletdatabase.CreatePostResponse
-	(*DeletePostRequest)(nil),                 // 4: vyletdatabase.DeletePostRequest
-	(*DeletePostResponse)(nil),                // 5: vyletdatabase.DeletePostResponse
-	(*GetPostsRequest)(nil),                   // 6: vyletdatabase.GetPostsRequest
-	(*GetPostsResponse)(nil),                  // 7: vyletdatabase.GetPostsResponse
-	(*GetPostsByActorRequest)(nil),            // 8: vyletdatabase.GetPostsByActorRequest
-	(*GetPostsByActorResponse)(nil),           // 9: vyletdatabase.GetPostsByActorResponse
-	(*GetPostInteractionCountsRequest)(nil),   // 10: vyletdatabase.GetPostInteractionCountsRequest
-	(*PostInteractionCounts)(nil),             // 11: vyletdatabase.PostInteractionCounts
-	(*GetPostInteractionCountsResponse)(nil),  // 12: vyletdatabase.GetPostInteractionCountsResponse
-	(*GetPostsInteractionCountsRequest)(nil),  // 13: vyletdatabase.GetPostsInteractionCountsRequest
-	(*GetPostsInteractionCountsResponse)(nil), // 14: vyletdatabase.GetPostsInteractionCountsResponse
-	nil,                           // 15: vyletdatabase.GetPostsResponse.PostsEntry
-	nil,                           // 16: vyletdatabase.GetPostsByActorResponse.PostsEntry
-	nil,                           // 17: vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(*Facet)(nil),                             // 1: vyletdatabase.Facet
+	(*FacetFeature)(nil),                      // 2: vyletdatabase.FacetFeature
+	(*Post)(nil),                              // 3: vyletdatabase.Post
+	(*CreatePostRequest)(nil),                 // 4: vyletdatabase.CreatePostRequest
+	(*CreatePostResponse)(nil),                // 5: vyletdatabase.CreatePostResponse
+	(*DeletePostRequest)(nil),                 // 6: vyletdatabase.DeletePostRequest
+	(*DeletePostResponse)(nil),                // 7: vyletdatabase.DeletePostResponse
+	(*GetPostsRequest)(nil),                   // 8: vyletdatabase.GetPostsRequest
+	(*GetPostsResponse)(nil),                  // 9: vyletdatabase.GetPostsResponse
+	(*GetPostsByActorRequest)(nil),            // 10: vyletdatabase.GetPostsByActorRequest
+	(*GetPostsByActorResponse)(nil),           // 11: vyletdatabase.GetPostsByActorResponse
+	(*GetPostInteractionCountsRequest)(nil),   // 12: vyletdatabase.GetPostInteractionCountsRequest
+	(*PostInteractionCounts)(nil),             // 13: vyletdatabase.PostInteractionCounts
+	(*GetPostInteractionCountsResponse)(nil),  // 14: vyletdatabase.GetPostInteractionCountsResponse
+	(*GetPostsInteractionCountsRequest)(nil),  // 15: vyletdatabase.GetPostsInteractionCountsRequest
+	(*GetPostsInteractionCountsResponse)(nil), // 16: vyletdatabase.GetPostsInteractionCountsResponse
+	nil,                           // 17: vyletdatabase.GetPostsResponse.PostsEntry
+	nil,                           // 18: vyletdatabase.GetPostsByActorResponse.PostsEntry
+	nil,                           // 19: vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry
+	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
 }
 var file_post_proto_depIdxs = []int32{
-	0,  // 0: vyletdatabase.Post.images:type_name -> vyletdatabase.Image
-	18, // 1: vyletdatabase.Post.created_at:type_name -> google.protobuf.Timestamp
-	18, // 2: vyletdatabase.Post.indexed_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: vyletdatabase.CreatePostRequest.post:type_name -> vyletdatabase.Post
-	15, // 4: vyletdatabase.GetPostsResponse.posts:type_name -> vyletdatabase.GetPostsResponse.PostsEntry
-	16, // 5: vyletdatabase.GetPostsByActorResponse.posts:type_name -> vyletdatabase.GetPostsByActorResponse.PostsEntry
-	11, // 6: vyletdatabase.GetPostInteractionCountsResponse.counts:type_name -> vyletdatabase.PostInteractionCounts
-	17, // 7: vyletdatabase.GetPostsInteractionCountsResponse.counts:type_name -> vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry
-	1,  // 8: vyletdatabase.GetPostsResponse.PostsEntry.value:type_name -> vyletdatabase.Post
-	1,  // 9: vyletdatabase.GetPostsByActorResponse.PostsEntry.value:type_name -> vyletdatabase.Post
-	11, // 10: vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry.value:type_name -> vyletdatabase.PostInteractionCounts
-	2,  // 11: vyletdatabase.PostService.CreatePost:input_type -> vyletdatabase.CreatePostRequest
-	4,  // 12: vyletdatabase.PostService.DeletePost:input_type -> vyletdatabase.DeletePostRequest
-	6,  // 13: vyletdatabase.PostService.GetPosts:input_type -> vyletdatabase.GetPostsRequest
-	8,  // 14: vyletdatabase.PostService.GetPostsByActor:input_type -> vyletdatabase.GetPostsByActorRequest
-	10, // 15: vyletdatabase.PostService.GetPostInteractionCounts:input_type -> vyletdatabase.GetPostInteractionCountsRequest
-	13, // 16: vyletdatabase.PostService.GetPostsInteractionCounts:input_type -> vyletdatabase.GetPostsInteractionCountsRequest
-	3,  // 17: vyletdatabase.PostService.CreatePost:output_type -> vyletdatabase.CreatePostResponse
-	5,  // 18: vyletdatabase.PostService.DeletePost:output_type -> vyletdatabase.DeletePostResponse
-	7,  // 19: vyletdatabase.PostService.GetPosts:output_type -> vyletdatabase.GetPostsResponse
-	9,  // 20: vyletdatabase.PostService.GetPostsByActor:output_type -> vyletdatabase.GetPostsByActorResponse
-	12, // 21: vyletdatabase.PostService.GetPostInteractionCounts:output_type -> vyletdatabase.GetPostInteractionCountsResponse
-	14, // 22: vyletdatabase.PostService.GetPostsInteractionCounts:output_type -> vyletdatabase.GetPostsInteractionCountsResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: vyletdatabase.Facet.features:type_name -> vyletdatabase.FacetFeature
+	0,  // 1: vyletdatabase.Post.images:type_name -> vyletdatabase.Image
+	20, // 2: vyletdatabase.Post.created_at:type_name -> google.protobuf.Timestamp
+	20, // 3: vyletdatabase.Post.indexed_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: vyletdatabase.Post.facets:type_name -> vyletdatabase.Facet
+	3,  // 5: vyletdatabase.CreatePostRequest.post:type_name -> vyletdatabase.Post
+	17, // 6: vyletdatabase.GetPostsResponse.posts:type_name -> vyletdatabase.GetPostsResponse.PostsEntry
+	18, // 7: vyletdatabase.GetPostsByActorResponse.posts:type_name -> vyletdatabase.GetPostsByActorResponse.PostsEntry
+	13, // 8: vyletdatabase.GetPostInteractionCountsResponse.counts:type_name -> vyletdatabase.PostInteractionCounts
+	19, // 9: vyletdatabase.GetPostsInteractionCountsResponse.counts:type_name -> vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry
+	3,  // 10: vyletdatabase.GetPostsResponse.PostsEntry.value:type_name -> vyletdatabase.Post
+	3,  // 11: vyletdatabase.GetPostsByActorResponse.PostsEntry.value:type_name -> vyletdatabase.Post
+	13, // 12: vyletdatabase.GetPostsInteractionCountsResponse.CountsEntry.value:type_name -> vyletdatabase.PostInteractionCounts
+	4,  // 13: vyletdatabase.PostService.CreatePost:input_type -> vyletdatabase.CreatePostRequest
+	6,  // 14: vyletdatabase.PostService.DeletePost:input_type -> vyletdatabase.DeletePostRequest
+	8,  // 15: vyletdatabase.PostService.GetPosts:input_type -> vyletdatabase.GetPostsRequest
+	10, // 16: vyletdatabase.PostService.GetPostsByActor:input_type -> vyletdatabase.GetPostsByActorRequest
+	12, // 17: vyletdatabase.PostService.GetPostInteractionCounts:input_type -> vyletdatabase.GetPostInteractionCountsRequest
+	15, // 18: vyletdatabase.PostService.GetPostsInteractionCounts:input_type -> vyletdatabase.GetPostsInteractionCountsRequest
+	5,  // 19: vyletdatabase.PostService.CreatePost:output_type -> vyletdatabase.CreatePostResponse
+	7,  // 20: vyletdatabase.PostService.DeletePost:output_type -> vyletdatabase.DeletePostResponse
+	9,  // 21: vyletdatabase.PostService.GetPosts:output_type -> vyletdatabase.GetPostsResponse
+	11, // 22: vyletdatabase.PostService.GetPostsByActor:output_type -> vyletdatabase.GetPostsByActorResponse
+	14, // 23: vyletdatabase.PostService.GetPostInteractionCounts:output_type -> vyletdatabase.GetPostInteractionCountsResponse
+	16, // 24: vyletdatabase.PostService.GetPostsInteractionCounts:output_type -> vyletdatabase.GetPostsInteractionCountsResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_post_proto_init() }
@@ -1010,13 +1152,14 @@ func file_post_proto_init() {
 		return
 	}
 	file_post_proto_msgTypes[0].OneofWrappers = []any{}
-	file_post_proto_msgTypes[1].OneofWrappers = []any{}
+	file_post_proto_msgTypes[2].OneofWrappers = []any{
+		(*FacetFeature_Mention)(nil),
+		(*FacetFeature_Link)(nil),
+		(*FacetFeature_Tag)(nil),
+	}
 	file_post_proto_msgTypes[3].OneofWrappers = []any{}
-	file_post_proto_msgTypes[5].OneofWrappers = []any{}
-	file_post_proto_msgTypes[7].OneofWrappers = []any{}
-	file_post_proto_msgTypes[8].OneofWrappers = []any{}
-	file_post_proto_msgTypes[9].OneofWrappers = []any{}
-	file_post_proto_msgTypes[12].OneofWrappers = []any{}
+	file_post_proto_msgTypes[10].OneofWrappers = []any{}
+	file_post_proto_msgTypes[11].OneofWrappers = []any{}
 	file_post_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1024,7 +1167,7 @@ func file_post_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_post_proto_rawDesc), len(file_post_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
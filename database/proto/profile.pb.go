@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -161,7 +162,6 @@ func (x *CreateProfileRequest) GetProfile() *Profile {
 
 type CreateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,11 +196,94 @@ func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
 	return file_profile_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateProfileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+type UpdateProfileRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Profile *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Fields of the profile to update. If unset, every updatable field is replaced. An empty mask only updates
+	// updated_at.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateProfileRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{4}
 }
 
 type DeleteProfileRequest struct {
@@ -212,7 +295,7 @@ type DeleteProfileRequest struct {
 
 func (x *DeleteProfileRequest) Reset() {
 	*x = DeleteProfileRequest{}
-	mi := &file_profile_proto_msgTypes[3]
+	mi := &file_profile_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +307,7 @@ func (x *DeleteProfileRequest) String() string {
 func (*DeleteProfileRequest) ProtoMessage() {}
 
 func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[3]
+	mi := &file_profile_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +320,7 @@ func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{3}
+	return file_profile_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteProfileRequest) GetDid() string {
@@ -249,14 +332,13 @@ func (x *DeleteProfileRequest) GetDid() string {
 
 type DeleteProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteProfileResponse) Reset() {
 	*x = DeleteProfileResponse{}
-	mi := &file_profile_proto_msgTypes[4]
+	mi := &file_profile_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +350,7 @@ func (x *DeleteProfileResponse) String() string {
 func (*DeleteProfileResponse) ProtoMessage() {}
 
 func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[4]
+	mi := &file_profile_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,14 +363,7 @@ func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProfileResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *DeleteProfileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_profile_proto_rawDescGZIP(), []int{6}
 }
 
 type GetProfileRequest struct {
@@ -300,7 +375,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_profile_proto_msgTypes[5]
+	mi := &file_profile_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +387,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[5]
+	mi := &file_profile_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +400,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{5}
+	return file_profile_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetProfileRequest) GetDid() string {
@@ -337,7 +412,6 @@ func (x *GetProfileRequest) GetDid() string {
 
 type GetProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Profile       *Profile               `protobuf:"bytes,2,opt,name=profile,proto3,oneof" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -345,7 +419,7 @@ type GetProfileResponse struct {
 
 func (x *GetProfileResponse) Reset() {
 	*x = GetProfileResponse{}
-	mi := &file_profile_proto_msgTypes[6]
+	mi := &file_profile_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +431,7 @@ func (x *GetProfileResponse) String() string {
 func (*GetProfileResponse) ProtoMessage() {}
 
 func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[6]
+	mi := &file_profile_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,14 +444,7 @@ func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetProfileResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
-	}
-	return ""
+	return file_profile_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetProfileResponse) GetProfile() *Profile {
@@ -396,7 +463,7 @@ type GetProfilesRequest struct {
 
 func (x *GetProfilesRequest) Reset() {
 	*x = GetProfilesRequest{}
-	mi := &file_profile_proto_msgTypes[7]
+	mi := &file_profile_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +475,7 @@ func (x *GetProfilesRequest) String() string {
 func (*GetProfilesRequest) ProtoMessage() {}
 
 func (x *GetProfilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[7]
+	mi := &file_profile_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +488,7 @@ func (x *GetProfilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfilesRequest.ProtoReflect.Descriptor instead.
 func (*GetProfilesRequest) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{7}
+	return file_profile_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetProfilesRequest) GetDids() []string {
@@ -433,7 +500,6 @@ func (x *GetProfilesRequest) GetDids() []string {
 
 type GetProfilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Profiles      map[string]*Profile    `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -441,7 +507,7 @@ type GetProfilesResponse struct {
 
 func (x *GetProfilesResponse) Reset() {
 	*x = GetProfilesResponse{}
-	mi := &file_profile_proto_msgTypes[8]
+	mi := &file_profile_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +519,7 @@ func (x *GetProfilesResponse) String() string {
 func (*GetProfilesResponse) ProtoMessage() {}
 
 func (x *GetProfilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_proto_msgTypes[8]
+	mi := &file_profile_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,28 +532,127 @@ func (x *GetProfilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfilesResponse.ProtoReflect.Descriptor instead.
 func (*GetProfilesResponse) Descriptor() ([]byte, []int) {
-	return file_profile_proto_rawDescGZIP(), []int{8}
+	return file_profile_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetProfilesResponse) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *GetProfilesResponse) GetProfiles() map[string]*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SetActorStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Did    string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Active bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// Why the account is inactive, such as takendown, suspended or deactivated
+	Status *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// Time of the account event, so that events applied out of order don't overwrite newer ones
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActorStatusRequest) Reset() {
+	*x = SetActorStatusRequest{}
+	mi := &file_profile_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActorStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActorStatusRequest) ProtoMessage() {}
+
+func (x *SetActorStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActorStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetActorStatusRequest) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetActorStatusRequest) GetDid() string {
+	if x != nil {
+		return x.Did
 	}
 	return ""
 }
 
-func (x *GetProfilesResponse) GetProfiles() map[string]*Profile {
+func (x *SetActorStatusRequest) GetActive() bool {
 	if x != nil {
-		return x.Profiles
+		return x.Active
+	}
+	return false
+}
+
+func (x *SetActorStatusRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *SetActorStatusRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
+type SetActorStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActorStatusResponse) Reset() {
+	*x = SetActorStatusResponse{}
+	mi := &file_profile_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActorStatusResponse) ProtoMessage() {}
+
+func (x *SetActorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActorStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetActorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_profile_proto_rawDescGZIP(), []int{12}
+}
+
 var File_profile_proto protoreflect.FileDescriptor
 
 const file_profile_proto_rawDesc = "" +
 	"\n" +
-	"\rprofile.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x02\n" +
+	"\rprofile.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x02\n" +
 	"\aProfile\x12\x18\n" +
 	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12&\n" +
 	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12%\n" +
@@ -503,39 +668,44 @@ const file_profile_proto_rawDesc = "" +
 	"\t_pronounsB\t\n" +
 	"\a_avatar\"H\n" +
 	"\x14CreateProfileRequest\x120\n" +
-	"\aprofile\x18\x01 \x01(\v2\x16.vyletdatabase.ProfileR\aprofile\"<\n" +
-	"\x15CreateProfileResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"0\n" +
+	"\aprofile\x18\x01 \x01(\v2\x16.vyletdatabase.ProfileR\aprofile\"$\n" +
+	"\x15CreateProfileResponseJ\x04\b\x01\x10\x02R\x05error\"\x85\x01\n" +
+	"\x14UpdateProfileRequest\x120\n" +
+	"\aprofile\x18\x01 \x01(\v2\x16.vyletdatabase.ProfileR\aprofile\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"$\n" +
+	"\x15UpdateProfileResponseJ\x04\b\x01\x10\x02R\x05error\"0\n" +
 	"\x14DeleteProfileRequest\x12\x18\n" +
-	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"<\n" +
-	"\x15DeleteProfileResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"-\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"$\n" +
+	"\x15DeleteProfileResponseJ\x04\b\x01\x10\x02R\x05error\"-\n" +
 	"\x11GetProfileRequest\x12\x18\n" +
-	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"|\n" +
-	"\x12GetProfileResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x125\n" +
-	"\aprofile\x18\x02 \x01(\v2\x16.vyletdatabase.ProfileH\x01R\aprofile\x88\x01\x01B\b\n" +
-	"\x06_errorB\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"d\n" +
+	"\x12GetProfileResponse\x125\n" +
+	"\aprofile\x18\x02 \x01(\v2\x16.vyletdatabase.ProfileH\x00R\aprofile\x88\x01\x01B\n" +
 	"\n" +
-	"\b_profile\"0\n" +
+	"\b_profileJ\x04\b\x01\x10\x02R\x05error\"0\n" +
 	"\x12GetProfilesRequest\x12\x1a\n" +
-	"\x04dids\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04dids\"\xdd\x01\n" +
-	"\x13GetProfilesResponse\x12\x19\n" +
-	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x12L\n" +
+	"\x04dids\x18\x01 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x04dids\"\xc5\x01\n" +
+	"\x13GetProfilesResponse\x12L\n" +
 	"\bprofiles\x18\x02 \x03(\v20.vyletdatabase.GetProfilesResponse.ProfilesEntryR\bprofiles\x1aS\n" +
 	"\rProfilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.vyletdatabase.ProfileR\x05value:\x028\x01B\b\n" +
-	"\x06_error2\xcd\x03\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.vyletdatabase.ProfileR\x05value:\x028\x01J\x04\b\x01\x10\x02R\x05error\"\xa9\x01\n" +
+	"\x15SetActorStatusRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x00R\x06status\x88\x01\x01\x126\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04timeB\t\n" +
+	"\a_status\"\x18\n" +
+	"\x16SetActorStatusResponse2\xb6\x04\n" +
 	"\x0eProfileService\x12Z\n" +
 	"\rCreateProfile\x12#.vyletdatabase.CreateProfileRequest\x1a$.vyletdatabase.CreateProfileResponse\x12Z\n" +
-	"\rUpdateProfile\x12#.vyletdatabase.CreateProfileRequest\x1a$.vyletdatabase.CreateProfileResponse\x12Z\n" +
-	"\rDeleteProfile\x12#.vyletdatabase.DeleteProfileRequest\x1a$.vyletdatabase.DeleteProfileResponse\x12Q\n" +
+	"\rUpdateProfile\x12#.vyletdatabase.UpdateProfileRequest\x1a$.vyletdatabase.UpdateProfileResponse\x12Z\n" +
+	"\rDeleteProfile\x12#.vyletdatabase.DeleteProfileRequest\x1a$.vyletdatabase.DeleteProfileResponse\x12]\n" +
+	"\x0eSetActorStatus\x12$.vyletdatabase.SetActorStatusRequest\x1a%.vyletdatabase.SetActorStatusResponse\x12V\n" +
 	"\n" +
-	"GetProfile\x12 .vyletdatabase.GetProfileRequest\x1a!.vyletdatabase.GetProfileResponse\x12T\n" +
-	"\vGetProfiles\x12!.vyletdatabase.GetProfilesRequest\x1a\".vyletdatabase.GetProfilesResponseB\x87\x01\n" +
+	"GetProfile\x12 .vyletdatabase.GetProfileRequest\x1a!.vyletdatabase.GetProfileResponse\"\x03\x90\x02\x01\x12Y\n" +
+	"\vGetProfiles\x12!.vyletdatabase.GetProfilesRequest\x1a\".vyletdatabase.GetProfilesResponse\"\x03\x90\x02\x01B\x87\x01\n" +
 	"\x11com.vyletdatabaseB\fProfileProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
 
 var (
@@ -550,42 +720,52 @@ func file_profile_proto_rawDescGZIP() []byte {
 	return file_profile_proto_rawDescData
 }
 
-var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_profile_proto_goTypes = []any{
-	(*Profile)(nil),               // 0: vyletdatabase.Profile
-	(*CreateProfileRequest)(nil),  // 1: vyletdatabase.CreateProfileRequest
-	(*CreateProfileResponse)(nil), // 2: vyletdatabase.CreateProfileResponse
-	(*DeleteProfileRequest)(nil),  // 3: vyletdatabase.DeleteProfileRequest
-	(*DeleteProfileResponse)(nil), // 4: vyletdatabase.DeleteProfileResponse
-	(*GetProfileRequest)(nil),     // 5: vyletdatabase.GetProfileRequest
-	(*GetProfileResponse)(nil),    // 6: vyletdatabase.GetProfileResponse
-	(*GetProfilesRequest)(nil),    // 7: vyletdatabase.GetProfilesRequest
-	(*GetProfilesResponse)(nil),   // 8: vyletdatabase.GetProfilesResponse
-	nil,                           // 9: vyletdatabase.GetProfilesResponse.ProfilesEntry
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*Profile)(nil),                // 0: vyletdatabase.Profile
+	(*CreateProfileRequest)(nil),   // 1: vyletdatabase.CreateProfileRequest
+	(*CreateProfileResponse)(nil),  // 2: vyletdatabase.CreateProfileResponse
+	(*UpdateProfileRequest)(nil),   // 3: vyletdatabase.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),  // 4: vyletdatabase.UpdateProfileResponse
+	(*DeleteProfileRequest)(nil),   // 5: vyletdatabase.DeleteProfileRequest
+	(*DeleteProfileResponse)(nil),  // 6: vyletdatabase.DeleteProfileResponse
+	(*GetProfileRequest)(nil),      // 7: vyletdatabase.GetProfileRequest
+	(*GetProfileResponse)(nil),     // 8: vyletdatabase.GetProfileResponse
+	(*GetProfilesRequest)(nil),     // 9: vyletdatabase.GetProfilesRequest
+	(*GetProfilesResponse)(nil),    // 10: vyletdatabase.GetProfilesResponse
+	(*SetActorStatusRequest)(nil),  // 11: vyletdatabase.SetActorStatusRequest
+	(*SetActorStatusResponse)(nil), // 12: vyletdatabase.SetActorStatusResponse
+	nil,                            // 13: vyletdatabase.GetProfilesResponse.ProfilesEntry
+	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),  // 15: google.protobuf.FieldMask
 }
 var file_profile_proto_depIdxs = []int32{
-	10, // 0: vyletdatabase.Profile.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: vyletdatabase.Profile.indexed_at:type_name -> google.protobuf.Timestamp
+	14, // 0: vyletdatabase.Profile.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: vyletdatabase.Profile.indexed_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: vyletdatabase.CreateProfileRequest.profile:type_name -> vyletdatabase.Profile
-	0,  // 3: vyletdatabase.GetProfileResponse.profile:type_name -> vyletdatabase.Profile
-	9,  // 4: vyletdatabase.GetProfilesResponse.profiles:type_name -> vyletdatabase.GetProfilesResponse.ProfilesEntry
-	0,  // 5: vyletdatabase.GetProfilesResponse.ProfilesEntry.value:type_name -> vyletdatabase.Profile
-	1,  // 6: vyletdatabase.ProfileService.CreateProfile:input_type -> vyletdatabase.CreateProfileRequest
-	1,  // 7: vyletdatabase.ProfileService.UpdateProfile:input_type -> vyletdatabase.CreateProfileRequest
-	3,  // 8: vyletdatabase.ProfileService.DeleteProfile:input_type -> vyletdatabase.DeleteProfileRequest
-	5,  // 9: vyletdatabase.ProfileService.GetProfile:input_type -> vyletdatabase.GetProfileRequest
-	7,  // 10: vyletdatabase.ProfileService.GetProfiles:input_type -> vyletdatabase.GetProfilesRequest
-	2,  // 11: vyletdatabase.ProfileService.CreateProfile:output_type -> vyletdatabase.CreateProfileResponse
-	2,  // 12: vyletdatabase.ProfileService.UpdateProfile:output_type -> vyletdatabase.CreateProfileResponse
-	4,  // 13: vyletdatabase.ProfileService.DeleteProfile:output_type -> vyletdatabase.DeleteProfileResponse
-	6,  // 14: vyletdatabase.ProfileService.GetProfile:output_type -> vyletdatabase.GetProfileResponse
-	8,  // 15: vyletdatabase.ProfileService.GetProfiles:output_type -> vyletdatabase.GetProfilesResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 3: vyletdatabase.UpdateProfileRequest.profile:type_name -> vyletdatabase.Profile
+	15, // 4: vyletdatabase.UpdateProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: vyletdatabase.GetProfileResponse.profile:type_name -> vyletdatabase.Profile
+	13, // 6: vyletdatabase.GetProfilesResponse.profiles:type_name -> vyletdatabase.GetProfilesResponse.ProfilesEntry
+	14, // 7: vyletdatabase.SetActorStatusRequest.time:type_name -> google.protobuf.Timestamp
+	0,  // 8: vyletdatabase.GetProfilesResponse.ProfilesEntry.value:type_name -> vyletdatabase.Profile
+	1,  // 9: vyletdatabase.ProfileService.CreateProfile:input_type -> vyletdatabase.CreateProfileRequest
+	3,  // 10: vyletdatabase.ProfileService.UpdateProfile:input_type -> vyletdatabase.UpdateProfileRequest
+	5,  // 11: vyletdatabase.ProfileService.DeleteProfile:input_type -> vyletdatabase.DeleteProfileRequest
+	11, // 12: vyletdatabase.ProfileService.SetActorStatus:input_type -> vyletdatabase.SetActorStatusRequest
+	7,  // 13: vyletdatabase.ProfileService.GetProfile:input_type -> vyletdatabase.GetProfileRequest
+	9,  // 14: vyletdatabase.ProfileService.GetProfiles:input_type -> vyletdatabase.GetProfilesRequest
+	2,  // 15: vyletdatabase.ProfileService.CreateProfile:output_type -> vyletdatabase.CreateProfileResponse
+	4,  // 16: vyletdatabase.ProfileService.UpdateProfile:output_type -> vyletdatabase.UpdateProfileResponse
+	6,  // 17: vyletdatabase.ProfileService.DeleteProfile:output_type -> vyletdatabase.DeleteProfileResponse
+	12, // 18: vyletdatabase.ProfileService.SetActorStatus:output_type -> vyletdatabase.SetActorStatusResponse
+	8,  // 19: vyletdatabase.ProfileService.GetProfile:output_type -> vyletdatabase.GetProfileResponse
+	10, // 20: vyletdatabase.ProfileService.GetProfiles:output_type -> vyletdatabase.GetProfilesResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_profile_proto_init() }
@@ -594,17 +774,15 @@ func file_profile_proto_init() {
 		return
 	}
 	file_profile_proto_msgTypes[0].OneofWrappers = []any{}
-	file_profile_proto_msgTypes[2].OneofWrappers = []any{}
-	file_profile_proto_msgTypes[4].OneofWrappers = []any{}
-	file_profile_proto_msgTypes[6].OneofWrappers = []any{}
 	file_profile_proto_msgTypes[8].OneofWrappers = []any{}
+	file_profile_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_profile_proto_rawDesc), len(file_profile_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
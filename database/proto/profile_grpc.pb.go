@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProfileService_CreateProfile_FullMethodName = "/vyletdatabase.ProfileService/CreateProfile"
-	ProfileService_UpdateProfile_FullMethodName = "/vyletdatabase.ProfileService/UpdateProfile"
-	ProfileService_DeleteProfile_FullMethodName = "/vyletdatabase.ProfileService/DeleteProfile"
-	ProfileService_GetProfile_FullMethodName    = "/vyletdatabase.ProfileService/GetProfile"
-	ProfileService_GetProfiles_FullMethodName   = "/vyletdatabase.ProfileService/GetProfiles"
+	ProfileService_CreateProfile_FullMethodName  = "/vyletdatabase.ProfileService/CreateProfile"
+	ProfileService_UpdateProfile_FullMethodName  = "/vyletdatabase.ProfileService/UpdateProfile"
+	ProfileService_DeleteProfile_FullMethodName  = "/vyletdatabase.ProfileService/DeleteProfile"
+	ProfileService_SetActorStatus_FullMethodName = "/vyletdatabase.ProfileService/SetActorStatus"
+	ProfileService_GetProfile_FullMethodName     = "/vyletdatabase.ProfileService/GetProfile"
+	ProfileService_GetProfiles_FullMethodName    = "/vyletdatabase.ProfileService/GetProfiles"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -31,8 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProfileServiceClient interface {
 	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
-	UpdateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
+	// Records whether an actor's account is active, as reported by their PDS. Inactive actors, such as taken down
+	// ones, are left out of search results.
+	SetActorStatus(ctx context.Context, in *SetActorStatusRequest, opts ...grpc.CallOption) (*SetActorStatusResponse, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	GetProfiles(ctx context.Context, in *GetProfilesRequest, opts ...grpc.CallOption) (*GetProfilesResponse, error)
 }
@@ -55,9 +59,9 @@ func (c *profileServiceClient) CreateProfile(ctx context.Context, in *CreateProf
 	return out, nil
 }
 
-func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
+func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateProfileResponse)
+	out := new(UpdateProfileResponse)
 	err := c.cc.Invoke(ctx, ProfileService_UpdateProfile_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -75,6 +79,16 @@ func (c *profileServiceClient) DeleteProfile(ctx context.Context, in *DeleteProf
 	return out, nil
 }
 
+func (c *profileServiceClient) SetActorStatus(ctx context.Context, in *SetActorStatusRequest, opts ...grpc.CallOption) (*SetActorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetActorStatusResponse)
+	err := c.cc.Invoke(ctx, ProfileService_SetActorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProfileResponse)
@@ -100,8 +114,11 @@ func (c *profileServiceClient) GetProfiles(ctx context.Context, in *GetProfilesR
 // for forward compatibility.
 type ProfileServiceServer interface {
 	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
-	UpdateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
+	// Records whether an actor's account is active, as reported by their PDS. Inactive actors, such as taken down
+	// ones, are left out of search results.
+	SetActorStatus(context.Context, *SetActorStatusRequest) (*SetActorStatusResponse, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	GetProfiles(context.Context, *GetProfilesRequest) (*GetProfilesResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
@@ -117,12 +134,15 @@ type UnimplementedProfileServiceServer struct{}
 func (UnimplementedProfileServiceServer) CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateProfile not implemented")
 }
-func (UnimplementedProfileServiceServer) UpdateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error) {
+func (UnimplementedProfileServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
 func (UnimplementedProfileServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProfile not implemented")
 }
+func (UnimplementedProfileServiceServer) SetActorStatus(context.Context, *SetActorStatusRequest) (*SetActorStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetActorStatus not implemented")
+}
 func (UnimplementedProfileServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
 }
@@ -169,7 +189,7 @@ func _ProfileService_CreateProfile_Handler(srv interface{}, ctx context.Context,
 }
 
 func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProfileRequest)
+	in := new(UpdateProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -181,7 +201,7 @@ func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context,
 		FullMethod: ProfileService_UpdateProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*CreateProfileRequest))
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -204,6 +224,24 @@ func _ProfileService_DeleteProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_SetActorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).SetActorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_SetActorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).SetActorStatus(ctx, req.(*SetActorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfileService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfileRequest)
 	if err := dec(in); err != nil {
@@ -259,6 +297,10 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProfile",
 			Handler:    _ProfileService_DeleteProfile_Handler,
 		},
+		{
+			MethodName: "SetActorStatus",
+			Handler:    _ProfileService_SetActorStatus_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _ProfileService_GetProfile_Handler,
@@ -0,0 +1,904 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: search.proto
+
+package vyletdatabase
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IndexPostRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Caption   *string                `protobuf:"bytes,3,opt,name=caption,proto3,oneof" json:"caption,omitempty"`
+	AltTexts  []string               `protobuf:"bytes,4,rep,name=alt_texts,json=altTexts,proto3" json:"alt_texts,omitempty"`
+	Tags      []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// CIDs of the post's images, which are checked for takedowns when searching
+	ImageCids     []string `protobuf:"bytes,6,rep,name=image_cids,json=imageCids,proto3" json:"image_cids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexPostRequest) Reset() {
+	*x = IndexPostRequest{}
+	mi := &file_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexPostRequest) ProtoMessage() {}
+
+func (x *IndexPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexPostRequest.ProtoReflect.Descriptor instead.
+func (*IndexPostRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexPostRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *IndexPostRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IndexPostRequest) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
+	}
+	return ""
+}
+
+func (x *IndexPostRequest) GetAltTexts() []string {
+	if x != nil {
+		return x.AltTexts
+	}
+	return nil
+}
+
+func (x *IndexPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *IndexPostRequest) GetImageCids() []string {
+	if x != nil {
+		return x.ImageCids
+	}
+	return nil
+}
+
+type IndexPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexPostResponse) Reset() {
+	*x = IndexPostResponse{}
+	mi := &file_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexPostResponse) ProtoMessage() {}
+
+func (x *IndexPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexPostResponse.ProtoReflect.Descriptor instead.
+func (*IndexPostResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{1}
+}
+
+type DeletePostFromIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostFromIndexRequest) Reset() {
+	*x = DeletePostFromIndexRequest{}
+	mi := &file_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostFromIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostFromIndexRequest) ProtoMessage() {}
+
+func (x *DeletePostFromIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostFromIndexRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostFromIndexRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeletePostFromIndexRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type DeletePostFromIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostFromIndexResponse) Reset() {
+	*x = DeletePostFromIndexResponse{}
+	mi := &file_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostFromIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostFromIndexResponse) ProtoMessage() {}
+
+func (x *DeletePostFromIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostFromIndexResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostFromIndexResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{3}
+}
+
+type IndexActorRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Did         string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Handle      *string                `protobuf:"bytes,2,opt,name=handle,proto3,oneof" json:"handle,omitempty"`
+	DisplayName *string                `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Typeahead results are ranked by this, newest first. When it isn't given, an actor that is already indexed keeps
+	// their existing rank.
+	RankedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ranked_at,json=rankedAt,proto3" json:"ranked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexActorRequest) Reset() {
+	*x = IndexActorRequest{}
+	mi := &file_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexActorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexActorRequest) ProtoMessage() {}
+
+func (x *IndexActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexActorRequest.ProtoReflect.Descriptor instead.
+func (*IndexActorRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IndexActorRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *IndexActorRequest) GetHandle() string {
+	if x != nil && x.Handle != nil {
+		return *x.Handle
+	}
+	return ""
+}
+
+func (x *IndexActorRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *IndexActorRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *IndexActorRequest) GetRankedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RankedAt
+	}
+	return nil
+}
+
+type IndexActorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexActorResponse) Reset() {
+	*x = IndexActorResponse{}
+	mi := &file_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexActorResponse) ProtoMessage() {}
+
+func (x *IndexActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexActorResponse.ProtoReflect.Descriptor instead.
+func (*IndexActorResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{5}
+}
+
+type DeleteActorFromIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Did           string                 `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActorFromIndexRequest) Reset() {
+	*x = DeleteActorFromIndexRequest{}
+	mi := &file_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActorFromIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActorFromIndexRequest) ProtoMessage() {}
+
+func (x *DeleteActorFromIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActorFromIndexRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActorFromIndexRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteActorFromIndexRequest) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+type DeleteActorFromIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActorFromIndexResponse) Reset() {
+	*x = DeleteActorFromIndexResponse{}
+	mi := &file_search_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActorFromIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActorFromIndexResponse) ProtoMessage() {}
+
+func (x *DeleteActorFromIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActorFromIndexResponse.ProtoReflect.Descriptor instead.
+func (*DeleteActorFromIndexResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{7}
+}
+
+type SearchPostsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Query  string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit  int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Posts with taken down images are only returned to their author
+	ViewerDid     *string `protobuf:"bytes,4,opt,name=viewer_did,json=viewerDid,proto3,oneof" json:"viewer_did,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_search_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetViewerDid() string {
+	if x != nil && x.ViewerDid != nil {
+		return *x.ViewerDid
+	}
+	return ""
+}
+
+type SearchPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching post URIs, newest first
+	Uris          []string `protobuf:"bytes,2,rep,name=uris,proto3" json:"uris,omitempty"`
+	Cursor        *string  `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_search_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchPostsResponse) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type SearchActorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActorsRequest) Reset() {
+	*x = SearchActorsRequest{}
+	mi := &file_search_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActorsRequest) ProtoMessage() {}
+
+func (x *SearchActorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActorsRequest.ProtoReflect.Descriptor instead.
+func (*SearchActorsRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchActorsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchActorsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchActorsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type SearchActorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dids          []string               `protobuf:"bytes,2,rep,name=dids,proto3" json:"dids,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActorsResponse) Reset() {
+	*x = SearchActorsResponse{}
+	mi := &file_search_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActorsResponse) ProtoMessage() {}
+
+func (x *SearchActorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActorsResponse.ProtoReflect.Descriptor instead.
+func (*SearchActorsResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchActorsResponse) GetDids() []string {
+	if x != nil {
+		return x.Dids
+	}
+	return nil
+}
+
+func (x *SearchActorsResponse) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type SearchActorsTypeaheadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActorsTypeaheadRequest) Reset() {
+	*x = SearchActorsTypeaheadRequest{}
+	mi := &file_search_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActorsTypeaheadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActorsTypeaheadRequest) ProtoMessage() {}
+
+func (x *SearchActorsTypeaheadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActorsTypeaheadRequest.ProtoReflect.Descriptor instead.
+func (*SearchActorsTypeaheadRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchActorsTypeaheadRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchActorsTypeaheadRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchActorsTypeaheadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DIDs of actors whose handle or display name starts with the query, most recently active first
+	Dids          []string `protobuf:"bytes,2,rep,name=dids,proto3" json:"dids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchActorsTypeaheadResponse) Reset() {
+	*x = SearchActorsTypeaheadResponse{}
+	mi := &file_search_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchActorsTypeaheadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchActorsTypeaheadResponse) ProtoMessage() {}
+
+func (x *SearchActorsTypeaheadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchActorsTypeaheadResponse.ProtoReflect.Descriptor instead.
+func (*SearchActorsTypeaheadResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchActorsTypeaheadResponse) GetDids() []string {
+	if x != nil {
+		return x.Dids
+	}
+	return nil
+}
+
+var File_search_proto protoreflect.FileDescriptor
+
+const file_search_proto_rawDesc = "" +
+	"\n" +
+	"\fsearch.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x01\n" +
+	"\x10IndexPostRequest\x12\x18\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\x12A\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12\x1d\n" +
+	"\acaption\x18\x03 \x01(\tH\x00R\acaption\x88\x01\x01\x12\x1b\n" +
+	"\talt_texts\x18\x04 \x03(\tR\baltTexts\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"image_cids\x18\x06 \x03(\tR\timageCidsB\n" +
+	"\n" +
+	"\b_caption\" \n" +
+	"\x11IndexPostResponseJ\x04\b\x01\x10\x02R\x05error\"6\n" +
+	"\x1aDeletePostFromIndexRequest\x12\x18\n" +
+	"\x03uri\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03uri\"*\n" +
+	"\x1bDeletePostFromIndexResponseJ\x04\b\x01\x10\x02R\x05error\"\xfe\x01\n" +
+	"\x11IndexActorRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\x12\x1b\n" +
+	"\x06handle\x18\x02 \x01(\tH\x00R\x06handle\x88\x01\x01\x12&\n" +
+	"\fdisplay_name\x18\x03 \x01(\tH\x01R\vdisplayName\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x127\n" +
+	"\tranked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\brankedAtB\t\n" +
+	"\a_handleB\x0f\n" +
+	"\r_display_nameB\x0e\n" +
+	"\f_description\"!\n" +
+	"\x12IndexActorResponseJ\x04\b\x01\x10\x02R\x05error\"7\n" +
+	"\x1bDeleteActorFromIndexRequest\x12\x18\n" +
+	"\x03did\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03did\"+\n" +
+	"\x1cDeleteActorFromIndexResponseJ\x04\b\x01\x10\x02R\x05error\"\xab\x01\n" +
+	"\x12SearchPostsRequest\x12\x1c\n" +
+	"\x05query\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"viewer_did\x18\x04 \x01(\tH\x01R\tviewerDid\x88\x01\x01B\t\n" +
+	"\a_cursorB\r\n" +
+	"\v_viewer_did\"^\n" +
+	"\x13SearchPostsResponse\x12\x12\n" +
+	"\x04uris\x18\x02 \x03(\tR\x04uris\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error\"y\n" +
+	"\x13SearchActorsRequest\x12\x1c\n" +
+	"\x05query\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"_\n" +
+	"\x14SearchActorsResponse\x12\x12\n" +
+	"\x04dids\x18\x02 \x03(\tR\x04dids\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error\"Z\n" +
+	"\x1cSearchActorsTypeaheadRequest\x12\x1c\n" +
+	"\x05query\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\"@\n" +
+	"\x1dSearchActorsTypeaheadResponse\x12\x12\n" +
+	"\x04dids\x18\x02 \x03(\tR\x04didsJ\x04\b\x01\x10\x02R\x05error2\xc3\x05\n" +
+	"\rSearchService\x12N\n" +
+	"\tIndexPost\x12\x1f.vyletdatabase.IndexPostRequest\x1a .vyletdatabase.IndexPostResponse\x12l\n" +
+	"\x13DeletePostFromIndex\x12).vyletdatabase.DeletePostFromIndexRequest\x1a*.vyletdatabase.DeletePostFromIndexResponse\x12Q\n" +
+	"\n" +
+	"IndexActor\x12 .vyletdatabase.IndexActorRequest\x1a!.vyletdatabase.IndexActorResponse\x12o\n" +
+	"\x14DeleteActorFromIndex\x12*.vyletdatabase.DeleteActorFromIndexRequest\x1a+.vyletdatabase.DeleteActorFromIndexResponse\x12Y\n" +
+	"\vSearchPosts\x12!.vyletdatabase.SearchPostsRequest\x1a\".vyletdatabase.SearchPostsResponse\"\x03\x90\x02\x01\x12\\\n" +
+	"\fSearchActors\x12\".vyletdatabase.SearchActorsRequest\x1a#.vyletdatabase.SearchActorsResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x15SearchActorsTypeahead\x12+.vyletdatabase.SearchActorsTypeaheadRequest\x1a,.vyletdatabase.SearchActorsTypeaheadResponse\"\x03\x90\x02\x01B\x86\x01\n" +
+	"\x11com.vyletdatabaseB\vSearchProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
+
+var (
+	file_search_proto_rawDescOnce sync.Once
+	file_search_proto_rawDescData []byte
+)
+
+func file_search_proto_rawDescGZIP() []byte {
+	file_search_proto_rawDescOnce.Do(func() {
+		file_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)))
+	})
+	return file_search_proto_rawDescData
+}
+
+var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_search_proto_goTypes = []any{
+	(*IndexPostRequest)(nil),              // 0: vyletdatabase.IndexPostRequest
+	(*IndexPostResponse)(nil),             // 1: vyletdatabase.IndexPostResponse
+	(*DeletePostFromIndexRequest)(nil),    // 2: vyletdatabase.DeletePostFromIndexRequest
+	(*DeletePostFromIndexResponse)(nil),   // 3: vyletdatabase.DeletePostFromIndexResponse
+	(*IndexActorRequest)(nil),             // 4: vyletdatabase.IndexActorRequest
+	(*IndexActorResponse)(nil),            // 5: vyletdatabase.IndexActorResponse
+	(*DeleteActorFromIndexRequest)(nil),   // 6: vyletdatabase.DeleteActorFromIndexRequest
+	(*DeleteActorFromIndexResponse)(nil),  // 7: vyletdatabase.DeleteActorFromIndexResponse
+	(*SearchPostsRequest)(nil),            // 8: vyletdatabase.SearchPostsRequest
+	(*SearchPostsResponse)(nil),           // 9: vyletdatabase.SearchPostsResponse
+	(*SearchActorsRequest)(nil),           // 10: vyletdatabase.SearchActorsRequest
+	(*SearchActorsResponse)(nil),          // 11: vyletdatabase.SearchActorsResponse
+	(*SearchActorsTypeaheadRequest)(nil),  // 12: vyletdatabase.SearchActorsTypeaheadRequest
+	(*SearchActorsTypeaheadResponse)(nil), // 13: vyletdatabase.SearchActorsTypeaheadResponse
+	(*timestamppb.Timestamp)(nil),         // 14: google.protobuf.Timestamp
+}
+var file_search_proto_depIdxs = []int32{
+	14, // 0: vyletdatabase.IndexPostRequest.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: vyletdatabase.IndexActorRequest.ranked_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: vyletdatabase.SearchService.IndexPost:input_type -> vyletdatabase.IndexPostRequest
+	2,  // 3: vyletdatabase.SearchService.DeletePostFromIndex:input_type -> vyletdatabase.DeletePostFromIndexRequest
+	4,  // 4: vyletdatabase.SearchService.IndexActor:input_type -> vyletdatabase.IndexActorRequest
+	6,  // 5: vyletdatabase.SearchService.DeleteActorFromIndex:input_type -> vyletdatabase.DeleteActorFromIndexRequest
+	8,  // 6: vyletdatabase.SearchService.SearchPosts:input_type -> vyletdatabase.SearchPostsRequest
+	10, // 7: vyletdatabase.SearchService.SearchActors:input_type -> vyletdatabase.SearchActorsRequest
+	12, // 8: vyletdatabase.SearchService.SearchActorsTypeahead:input_type -> vyletdatabase.SearchActorsTypeaheadRequest
+	1,  // 9: vyletdatabase.SearchService.IndexPost:output_type -> vyletdatabase.IndexPostResponse
+	3,  // 10: vyletdatabase.SearchService.DeletePostFromIndex:output_type -> vyletdatabase.DeletePostFromIndexResponse
+	5,  // 11: vyletdatabase.SearchService.IndexActor:output_type -> vyletdatabase.IndexActorResponse
+	7,  // 12: vyletdatabase.SearchService.DeleteActorFromIndex:output_type -> vyletdatabase.DeleteActorFromIndexResponse
+	9,  // 13: vyletdatabase.SearchService.SearchPosts:output_type -> vyletdatabase.SearchPostsResponse
+	11, // 14: vyletdatabase.SearchService.SearchActors:output_type -> vyletdatabase.SearchActorsResponse
+	13, // 15: vyletdatabase.SearchService.SearchActorsTypeahead:output_type -> vyletdatabase.SearchActorsTypeaheadResponse
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_search_proto_init() }
+func file_search_proto_init() {
+	if File_search_proto != nil {
+		return
+	}
+	file_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_search_proto_msgTypes[4].OneofWrappers = []any{}
+	file_search_proto_msgTypes[8].OneofWrappers = []any{}
+	file_search_proto_msgTypes[9].OneofWrappers = []any{}
+	file_search_proto_msgTypes[10].OneofWrappers = []any{}
+	file_search_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_search_proto_goTypes,
+		DependencyIndexes: file_search_proto_depIdxs,
+		MessageInfos:      file_search_proto_msgTypes,
+	}.Build()
+	File_search_proto = out.File
+	file_search_proto_goTypes = nil
+	file_search_proto_depIdxs = nil
+}
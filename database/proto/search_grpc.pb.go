@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: search.proto
+
+package vyletdatabase
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SearchService_IndexPost_FullMethodName             = "/vyletdatabase.SearchService/IndexPost"
+	SearchService_DeletePostFromIndex_FullMethodName   = "/vyletdatabase.SearchService/DeletePostFromIndex"
+	SearchService_IndexActor_FullMethodName            = "/vyletdatabase.SearchService/IndexActor"
+	SearchService_DeleteActorFromIndex_FullMethodName  = "/vyletdatabase.SearchService/DeleteActorFromIndex"
+	SearchService_SearchPosts_FullMethodName           = "/vyletdatabase.SearchService/SearchPosts"
+	SearchService_SearchActors_FullMethodName          = "/vyletdatabase.SearchService/SearchActors"
+	SearchService_SearchActorsTypeahead_FullMethodName = "/vyletdatabase.SearchService/SearchActorsTypeahead"
+)
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	IndexPost(ctx context.Context, in *IndexPostRequest, opts ...grpc.CallOption) (*IndexPostResponse, error)
+	DeletePostFromIndex(ctx context.Context, in *DeletePostFromIndexRequest, opts ...grpc.CallOption) (*DeletePostFromIndexResponse, error)
+	IndexActor(ctx context.Context, in *IndexActorRequest, opts ...grpc.CallOption) (*IndexActorResponse, error)
+	DeleteActorFromIndex(ctx context.Context, in *DeleteActorFromIndexRequest, opts ...grpc.CallOption) (*DeleteActorFromIndexResponse, error)
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	SearchActors(ctx context.Context, in *SearchActorsRequest, opts ...grpc.CallOption) (*SearchActorsResponse, error)
+	SearchActorsTypeahead(ctx context.Context, in *SearchActorsTypeaheadRequest, opts ...grpc.CallOption) (*SearchActorsTypeaheadResponse, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) IndexPost(ctx context.Context, in *IndexPostRequest, opts ...grpc.CallOption) (*IndexPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IndexPostResponse)
+	err := c.cc.Invoke(ctx, SearchService_IndexPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) DeletePostFromIndex(ctx context.Context, in *DeletePostFromIndexRequest, opts ...grpc.CallOption) (*DeletePostFromIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostFromIndexResponse)
+	err := c.cc.Invoke(ctx, SearchService_DeletePostFromIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) IndexActor(ctx context.Context, in *IndexActorRequest, opts ...grpc.CallOption) (*IndexActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IndexActorResponse)
+	err := c.cc.Invoke(ctx, SearchService_IndexActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) DeleteActorFromIndex(ctx context.Context, in *DeleteActorFromIndexRequest, opts ...grpc.CallOption) (*DeleteActorFromIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteActorFromIndexResponse)
+	err := c.cc.Invoke(ctx, SearchService_DeleteActorFromIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, SearchService_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) SearchActors(ctx context.Context, in *SearchActorsRequest, opts ...grpc.CallOption) (*SearchActorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchActorsResponse)
+	err := c.cc.Invoke(ctx, SearchService_SearchActors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) SearchActorsTypeahead(ctx context.Context, in *SearchActorsTypeaheadRequest, opts ...grpc.CallOption) (*SearchActorsTypeaheadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchActorsTypeaheadResponse)
+	err := c.cc.Invoke(ctx, SearchService_SearchActorsTypeahead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+// All implementations must embed UnimplementedSearchServiceServer
+// for forward compatibility.
+type SearchServiceServer interface {
+	IndexPost(context.Context, *IndexPostRequest) (*IndexPostResponse, error)
+	DeletePostFromIndex(context.Context, *DeletePostFromIndexRequest) (*DeletePostFromIndexResponse, error)
+	IndexActor(context.Context, *IndexActorRequest) (*IndexActorResponse, error)
+	DeleteActorFromIndex(context.Context, *DeleteActorFromIndexRequest) (*DeleteActorFromIndexResponse, error)
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	SearchActors(context.Context, *SearchActorsRequest) (*SearchActorsResponse, error)
+	SearchActorsTypeahead(context.Context, *SearchActorsTypeaheadRequest) (*SearchActorsTypeaheadResponse, error)
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+// UnimplementedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchServiceServer struct{}
+
+func (UnimplementedSearchServiceServer) IndexPost(context.Context, *IndexPostRequest) (*IndexPostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IndexPost not implemented")
+}
+func (UnimplementedSearchServiceServer) DeletePostFromIndex(context.Context, *DeletePostFromIndexRequest) (*DeletePostFromIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePostFromIndex not implemented")
+}
+func (UnimplementedSearchServiceServer) IndexActor(context.Context, *IndexActorRequest) (*IndexActorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IndexActor not implemented")
+}
+func (UnimplementedSearchServiceServer) DeleteActorFromIndex(context.Context, *DeleteActorFromIndexRequest) (*DeleteActorFromIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteActorFromIndex not implemented")
+}
+func (UnimplementedSearchServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedSearchServiceServer) SearchActors(context.Context, *SearchActorsRequest) (*SearchActorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchActors not implemented")
+}
+func (UnimplementedSearchServiceServer) SearchActorsTypeahead(context.Context, *SearchActorsTypeaheadRequest) (*SearchActorsTypeaheadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchActorsTypeahead not implemented")
+}
+func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
+func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServiceServer will
+// result in compilation errors.
+type UnsafeSearchServiceServer interface {
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+func RegisterSearchServiceServer(s grpc.ServiceRegistrar, srv SearchServiceServer) {
+	// If the following call panics, it indicates UnimplementedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SearchService_ServiceDesc, srv)
+}
+
+func _SearchService_IndexPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).IndexPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_IndexPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).IndexPost(ctx, req.(*IndexPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_DeletePostFromIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostFromIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).DeletePostFromIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_DeletePostFromIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).DeletePostFromIndex(ctx, req.(*DeletePostFromIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_IndexActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).IndexActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_IndexActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).IndexActor(ctx, req.(*IndexActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_DeleteActorFromIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActorFromIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).DeleteActorFromIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_DeleteActorFromIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).DeleteActorFromIndex(ctx, req.(*DeleteActorFromIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_SearchActors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchActorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchActors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchActors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchActors(ctx, req.(*SearchActorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_SearchActorsTypeahead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchActorsTypeaheadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchActorsTypeahead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchActorsTypeahead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchActorsTypeahead(ctx, req.(*SearchActorsTypeaheadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vyletdatabase.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IndexPost",
+			Handler:    _SearchService_IndexPost_Handler,
+		},
+		{
+			MethodName: "DeletePostFromIndex",
+			Handler:    _SearchService_DeletePostFromIndex_Handler,
+		},
+		{
+			MethodName: "IndexActor",
+			Handler:    _SearchService_IndexActor_Handler,
+		},
+		{
+			MethodName: "DeleteActorFromIndex",
+			Handler:    _SearchService_DeleteActorFromIndex_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _SearchService_SearchPosts_Handler,
+		},
+		{
+			MethodName: "SearchActors",
+			Handler:    _SearchService_SearchActors_Handler,
+		},
+		{
+			MethodName: "SearchActorsTypeahead",
+			Handler:    _SearchService_SearchActorsTypeahead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "search.proto",
+}
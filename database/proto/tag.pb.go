@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tag.proto
+
+package vyletdatabase
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPostsByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsByTagRequest) Reset() {
+	*x = GetPostsByTagRequest{}
+	mi := &file_tag_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsByTagRequest) ProtoMessage() {}
+
+func (x *GetPostsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPostsByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetPostsByTagRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsByTagRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetPostsByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         map[string]*Post       `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsByTagResponse) Reset() {
+	*x = GetPostsByTagResponse{}
+	mi := &file_tag_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsByTagResponse) ProtoMessage() {}
+
+func (x *GetPostsByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsByTagResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsByTagResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPostsByTagResponse) GetPosts() map[string]*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsByTagResponse) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetTrendingTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of hours, including the current one, to count tag usage over
+	WindowHours   int64 `protobuf:"varint,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingTagsRequest) Reset() {
+	*x = GetTrendingTagsRequest{}
+	mi := &file_tag_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingTagsRequest) ProtoMessage() {}
+
+func (x *GetTrendingTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingTagsRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTrendingTagsRequest) GetWindowHours() int64 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *GetTrendingTagsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingTag) Reset() {
+	*x = TrendingTag{}
+	mi := &file_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTag) ProtoMessage() {}
+
+func (x *TrendingTag) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTag.ProtoReflect.Descriptor instead.
+func (*TrendingTag) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TrendingTag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TrendingTag) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTrendingTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TrendingTag         `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingTagsResponse) Reset() {
+	*x = GetTrendingTagsResponse{}
+	mi := &file_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingTagsResponse) ProtoMessage() {}
+
+func (x *GetTrendingTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingTagsResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTrendingTagsResponse) GetTags() []*TrendingTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_tag_proto protoreflect.FileDescriptor
+
+const file_tag_proto_rawDesc = "" +
+	"\n" +
+	"\ttag.proto\x12\rvyletdatabase\x1a\x1bbuf/validate/validate.proto\x1a\n" +
+	"post.proto\"v\n" +
+	"\x14GetPostsByTagRequest\x12\x18\n" +
+	"\x03tag\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03tag\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\xe2\x01\n" +
+	"\x15GetPostsByTagResponse\x12E\n" +
+	"\x05posts\x18\x02 \x03(\v2/.vyletdatabase.GetPostsByTagResponse.PostsEntryR\x05posts\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01\x1aM\n" +
+	"\n" +
+	"PostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.vyletdatabase.PostR\x05value:\x028\x01B\t\n" +
+	"\a_cursorJ\x04\b\x01\x10\x02R\x05error\"a\n" +
+	"\x16GetTrendingTagsRequest\x12)\n" +
+	"\fwindow_hours\x18\x01 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\vwindowHours\x12\x1c\n" +
+	"\x05limit\x18\x02 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05limit\"5\n" +
+	"\vTrendingTag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"V\n" +
+	"\x17GetTrendingTagsResponse\x12.\n" +
+	"\x04tags\x18\x02 \x03(\v2\x1a.vyletdatabase.TrendingTagR\x04tagsJ\x04\b\x01\x10\x02R\x05error2\xd4\x01\n" +
+	"\n" +
+	"TagService\x12_\n" +
+	"\rGetPostsByTag\x12#.vyletdatabase.GetPostsByTagRequest\x1a$.vyletdatabase.GetPostsByTagResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x0fGetTrendingTags\x12%.vyletdatabase.GetTrendingTagsRequest\x1a&.vyletdatabase.GetTrendingTagsResponse\"\x03\x90\x02\x01B\x83\x01\n" +
+	"\x11com.vyletdatabaseB\bTagProtoP\x01Z\x10./;vyletdatabase\xa2\x02\x03VXX\xaa\x02\rVyletdatabase\xca\x02\rVyletdatabase\xe2\x02\x19Vyletdatabase\\GPBMetadata\xea\x02\rVyletdatabaseb\x06proto3"
+
+var (
+	file_tag_proto_rawDescOnce sync.Once
+	file_tag_proto_rawDescData []byte
+)
+
+func file_tag_proto_rawDescGZIP() []byte {
+	file_tag_proto_rawDescOnce.Do(func() {
+		file_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tag_proto_rawDesc), len(file_tag_proto_rawDesc)))
+	})
+	return file_tag_proto_rawDescData
+}
+
+var file_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_tag_proto_goTypes = []any{
+	(*GetPostsByTagRequest)(nil),    // 0: vyletdatabase.GetPostsByTagRequest
+	(*GetPostsByTagResponse)(nil),   // 1: vyletdatabase.GetPostsByTagResponse
+	(*GetTrendingTagsRequest)(nil),  // 2: vyletdatabase.GetTrendingTagsRequest
+	(*TrendingTag)(nil),             // 3: vyletdatabase.TrendingTag
+	(*GetTrendingTagsResponse)(nil), // 4: vyletdatabase.GetTrendingTagsResponse
+	nil,                             // 5: vyletdatabase.GetPostsByTagResponse.PostsEntry
+	(*Post)(nil),                    // 6: vyletdatabase.Post
+}
+var file_tag_proto_depIdxs = []int32{
+	5, // 0: vyletdatabase.GetPostsByTagResponse.posts:type_name -> vyletdatabase.GetPostsByTagResponse.PostsEntry
+	3, // 1: vyletdatabase.GetTrendingTagsResponse.tags:type_name -> vyletdatabase.TrendingTag
+	6, // 2: vyletdatabase.GetPostsByTagResponse.PostsEntry.value:type_name -> vyletdatabase.Post
+	0, // 3: vyletdatabase.TagService.GetPostsByTag:input_type -> vyletdatabase.GetPostsByTagRequest
+	2, // 4: vyletdatabase.TagService.GetTrendingTags:input_type -> vyletdatabase.GetTrendingTagsRequest
+	1, // 5: vyletdatabase.TagService.GetPostsByTag:output_type -> vyletdatabase.GetPostsByTagResponse
+	4, // 6: vyletdatabase.TagService.GetTrendingTags:output_type -> vyletdatabase.GetTrendingTagsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_tag_proto_init() }
+func file_tag_proto_init() {
+	if File_tag_proto != nil {
+		return
+	}
+	file_post_proto_init()
+	file_tag_proto_msgTypes[0].OneofWrappers = []any{}
+	file_tag_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tag_proto_rawDesc), len(file_tag_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tag_proto_goTypes,
+		DependencyIndexes: file_tag_proto_depIdxs,
+		MessageInfos:      file_tag_proto_msgTypes,
+	}.Build()
+	File_tag_proto = out.File
+	file_tag_proto_goTypes = nil
+	file_tag_proto_depIdxs = nil
+}
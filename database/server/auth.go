@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Rule that allows every RPC whose method is marked with idempotency_level = NO_SIDE_EFFECTS
+const authzRuleRead = "read"
+
+// Builds the server's transport credentials. A certificate is required unless serving without TLS, since clients
+// verify the server's certificate and would reject a generated one. With a client CA every client must present a
+// certificate signed by it, which identifies the client for authorization.
+func newServerCredentials(logger *slog.Logger, args *Args) (credentials.TransportCredentials, error) {
+	if args.Insecure {
+		logger.Warn("serving without TLS. this should only be used for local development")
+		return insecure.NewCredentials(), nil
+	}
+
+	if args.TLSCertFile == "" || args.TLSKeyFile == "" {
+		return nil, fmt.Errorf("a TLS certificate and key are required unless serving without TLS")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(args.TLSCertFile, args.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS13,
+	}
+
+	if args.TLSClientCAFile != "" {
+		pool, err := loadCertPool(args.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client CA: %w", err)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+
+	return pool, nil
+}
+
+// Decides which RPCs each client may call. Clients are identified by the common name of the certificate they present
+// over mutual TLS, and each is given a list of rules, any of which allows an RPC:
+//
+//   - "*" allows every RPC
+//   - "read" allows every RPC without side effects
+//   - "/vyletdatabase.PostService/*" allows every RPC of a service
+//   - "/vyletdatabase.PostService/GetPosts" allows a single RPC
+type authorizer struct {
+	rules map[string][]string
+}
+
+// Loads an authorization policy from a JSON file that maps client identities to their rules, e.g.
+// {"api": ["read", "/vyletdatabase.PostService/CreatePost"], "indexer": ["*"]}
+func loadAuthorizer(path string) (*authorizer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization policy: %w", err)
+	}
+
+	var rules map[string][]string
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization policy: %w", err)
+	}
+
+	return &authorizer{
+		rules: rules,
+	}, nil
+}
+
+func (a *authorizer) allowed(identity string, fullMethod string) bool {
+	for _, rule := range a.rules[identity] {
+		switch {
+		case rule == "*", rule == fullMethod:
+			return true
+		case rule == authzRuleRead:
+			if methodHasNoSideEffects(fullMethod) {
+				return true
+			}
+		case strings.HasSuffix(rule, "/*"):
+			if strings.HasPrefix(fullMethod, strings.TrimSuffix(rule, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns whether an RPC is marked as having no side effects in its proto definition
+func methodHasNoSideEffects(fullMethod string) bool {
+	// Full methods look like /vyletdatabase.PostService/GetPosts, while descriptors are named
+	// vyletdatabase.PostService.GetPosts
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return false
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+	opts, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok {
+		return false
+	}
+	return opts.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// Returns the identity of the client that made a request, from its verified certificate
+func clientIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+func (a *authorizer) authorize(ctx context.Context, fullMethod string) error {
+	identity, ok := clientIdentity(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	// Every client may check the server's health, which clients use to pick replicas
+	if strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+	if !a.allowed(identity, fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "%s may not call %s", identity, fullMethod)
+	}
+	return nil
+}
+
+func (a *authorizer) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authorizer) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewServerCredentialsRequiresCertificate(t *testing.T) {
+	if _, err := newServerCredentials(slog.Default(), &Args{}); err == nil {
+		t.Fatal("expected an error without a certificate")
+	}
+	if _, err := newServerCredentials(slog.Default(), &Args{TLSCertFile: "cert.pem"}); err == nil {
+		t.Fatal("expected an error without a key")
+	}
+	if _, err := newServerCredentials(slog.Default(), &Args{Insecure: true}); err != nil {
+		t.Fatalf("unexpected error serving without TLS: %v", err)
+	}
+}
+
+func TestAuthorizerAllowed(t *testing.T) {
+	a := &authorizer{
+		rules: map[string][]string{
+			"api":     {"read", "/vyletdatabase.PostService/CreatePost"},
+			"indexer": {"*"},
+			"cdn":     {"/vyletdatabase.BlobRefService/*"},
+		},
+	}
+
+	tests := []struct {
+		identity   string
+		fullMethod string
+		want       bool
+	}{
+		{"indexer", "/vyletdatabase.PostService/DeletePost", true},
+		{"indexer", "/vyletdatabase.BlobRefService/UpdateBlobRef", true},
+
+		// read only allows RPCs marked as having no side effects
+		{"api", "/vyletdatabase.PostService/GetPosts", true},
+		{"api", "/vyletdatabase.PostService/GetPostsByActor", true},
+		{"api", "/vyletdatabase.PostService/DeletePost", false},
+		{"api", "/vyletdatabase.PostService/Unknown", false},
+		{"api", "/vyletdatabase.PostService/CreatePost", true},
+
+		// Service rules only match that service's RPCs
+		{"cdn", "/vyletdatabase.BlobRefService/UpdateBlobRef", true},
+		{"cdn", "/vyletdatabase.BlobRefService/GetBlobRef", true},
+		{"cdn", "/vyletdatabase.BlobRefServiceExtra/GetBlobRef", false},
+		{"cdn", "/vyletdatabase.PostService/GetPosts", false},
+
+		{"unknown", "/vyletdatabase.PostService/GetPosts", false},
+		{"", "/vyletdatabase.PostService/GetPosts", false},
+	}
+
+	for _, tt := range tests {
+		if got := a.allowed(tt.identity, tt.fullMethod); got != tt.want {
+			t.Errorf("allowed(%q, %q) = %t, want %t", tt.identity, tt.fullMethod, got, tt.want)
+		}
+	}
+}
+
+// Returns a context for a request from a client that presented a verified certificate with the given common name
+func clientContext(commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			},
+		},
+	})
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	a := &authorizer{
+		rules: map[string][]string{
+			"cdn": {"/vyletdatabase.BlobRefService/*"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		want       codes.Code
+	}{
+		{"allowed", clientContext("cdn"), "/vyletdatabase.BlobRefService/GetBlobRef", codes.OK},
+		{"not allowed", clientContext("cdn"), "/vyletdatabase.PostService/GetPosts", codes.PermissionDenied},
+		{"health check bypasses rules", clientContext("cdn"), "/grpc.health.v1.Health/Check", codes.OK},
+		{"health check by a client without rules", clientContext("other"), "/grpc.health.v1.Health/Watch", codes.OK},
+		{"health check without a certificate", context.Background(), "/grpc.health.v1.Health/Check", codes.Unauthenticated},
+		{"no certificate", context.Background(), "/vyletdatabase.BlobRefService/GetBlobRef", codes.Unauthenticated},
+		{"unverified certificate", peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}}), "/vyletdatabase.BlobRefService/GetBlobRef", codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(a.authorize(tt.ctx, tt.fullMethod)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,12 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
-	"github.com/vylet-app/go/internal/helpers"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -14,17 +15,17 @@ func (s *Server) GetBlobRef(ctx context.Context, req *vyletdatabase.GetBlobRefRe
 	logger := s.logger.With("name", "GetBlobRef", "did", req.Did, "cid", req.Cid)
 
 	query := `
-		SELECT did, cid, first_seen_at, processed_at, updated_at, taken_down, takedown_reason, taken_down_at, tags
+		SELECT did, cid, first_seen_at, processed_at, updated_at, taken_down, takedown_reason, taken_down_at, tags, unreferenced_at, orphaned, orphaned_at
 		FROM blob_refs
 		WHERE did = ? AND cid = ?
 	`
 
 	blobRef := &vyletdatabase.BlobRef{}
 	var firstSeenAt, updatedAt time.Time
-	var processedAt, takenDownAt *time.Time
+	var processedAt, takenDownAt, unreferencedAt, orphanedAt *time.Time
 	var tags []string
 
-	err := s.cqlSession.Query(query, req.Did, req.Cid).WithContext(ctx).Scan(
+	err := s.readQuery(query, req.Did, req.Cid).WithContext(ctx).Scan(
 		&blobRef.Did,
 		&blobRef.Cid,
 		&firstSeenAt,
@@ -34,19 +35,18 @@ func (s *Server) GetBlobRef(ctx context.Context, req *vyletdatabase.GetBlobRefRe
 		&blobRef.TakedownReason,
 		&takenDownAt,
 		&tags,
+		&unreferencedAt,
+		&blobRef.Orphaned,
+		&orphanedAt,
 	)
 
 	if err != nil {
 		if err == gocql.ErrNotFound {
 			logger.Warn("blob ref not found", "did", req.Did, "cid", req.Cid)
-			return &vyletdatabase.GetBlobRefResponse{
-				Error: helpers.ToStringPtr("blob ref not found"),
-			}, nil
+			return nil, notFoundError("blob ref not found")
 		}
 		logger.Error("failed to fetch blob ref", "did", req.Did, "cid", req.Cid, "err", err)
-		return &vyletdatabase.GetBlobRefResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	blobRef.FirstSeenAt = timestamppb.New(firstSeenAt)
@@ -57,6 +57,12 @@ func (s *Server) GetBlobRef(ctx context.Context, req *vyletdatabase.GetBlobRefRe
 	if takenDownAt != nil {
 		blobRef.TakenDownAt = timestamppb.New(*takenDownAt)
 	}
+	if unreferencedAt != nil {
+		blobRef.UnreferencedAt = timestamppb.New(*unreferencedAt)
+	}
+	if orphanedAt != nil {
+		blobRef.OrphanedAt = timestamppb.New(*orphanedAt)
+	}
 	blobRef.Tags = tags
 
 	return &vyletdatabase.GetBlobRefResponse{
@@ -100,9 +106,7 @@ func (s *Server) CreateBlobRef(ctx context.Context, req *vyletdatabase.CreateBlo
 
 	if err != nil {
 		logger.Error("failed to create blob ref", "did", req.BlobRef.Did, "cid", req.BlobRef.Cid, "err", err)
-		return &vyletdatabase.CreateBlobRefResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.CreateBlobRefResponse{}, nil
@@ -123,29 +127,109 @@ func (s *Server) UpdateBlobRef(ctx context.Context, req *vyletdatabase.UpdateBlo
 		takenDownAt = &t
 	}
 
-	query := `
+	assignments, values, err := maskedAssignments(req.UpdateMask, map[string]any{
+		"processed_at":    processedAt,
+		"taken_down":      req.BlobRef.TakenDown,
+		"takedown_reason": req.BlobRef.TakedownReason,
+		"taken_down_at":   takenDownAt,
+		"tags":            req.BlobRef.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	assignments = append(assignments, "updated_at = ?")
+	values = append(values, now, req.BlobRef.Did, req.BlobRef.Cid)
+
+	// Only blob refs that exist are updated, since an update would otherwise create one
+	exists, err := s.blobRefExists(ctx, req.BlobRef.Did, req.BlobRef.Cid)
+	if err != nil {
+		logger.Error("failed to get blob ref", "err", err)
+		return nil, databaseError(err)
+	}
+	if !exists {
+		return nil, notFoundError("blob ref not found")
+	}
+
+	query := fmt.Sprintf(`
 		UPDATE blob_refs
-		SET processed_at = ?, updated_at = ?, taken_down = ?, takedown_reason = ?, taken_down_at = ?, tags = ?
+		SET %s
 		WHERE did = ? AND cid = ?
-	`
-
-	err := s.cqlSession.Query(query,
-		processedAt,
-		now,
-		req.BlobRef.TakenDown,
-		req.BlobRef.TakedownReason,
-		takenDownAt,
-		req.BlobRef.Tags,
-		req.BlobRef.Did,
-		req.BlobRef.Cid,
-	).WithContext(ctx).Exec()
+	`, strings.Join(assignments, ", "))
 
-	if err != nil {
+	if err := s.cqlSession.Query(query, values...).WithContext(ctx).Exec(); err != nil {
 		logger.Error("failed to update blob ref", "did", req.BlobRef.Did, "cid", req.BlobRef.Cid, "err", err)
-		return &vyletdatabase.UpdateBlobRefResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.UpdateBlobRefResponse{}, nil
 }
+
+// Tags are added and removed with set operations, so that concurrent changes to different tags don't overwrite each
+// other the way replacing the whole set with UpdateBlobRef would
+func (s *Server) AddBlobRefTags(ctx context.Context, req *vyletdatabase.AddBlobRefTagsRequest) (*vyletdatabase.AddBlobRefTagsResponse, error) {
+	logger := s.logger.With("name", "AddBlobRefTags", "did", req.Did, "cid", req.Cid)
+
+	if err := s.updateBlobRefTags(ctx, req.Did, req.Cid, "tags + ?", req.Tags); err != nil {
+		if err != gocql.ErrNotFound {
+			logger.Error("failed to add blob ref tags", "err", err)
+		}
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.AddBlobRefTagsResponse{}, nil
+}
+
+func (s *Server) RemoveBlobRefTags(ctx context.Context, req *vyletdatabase.RemoveBlobRefTagsRequest) (*vyletdatabase.RemoveBlobRefTagsResponse, error) {
+	logger := s.logger.With("name", "RemoveBlobRefTags", "did", req.Did, "cid", req.Cid)
+
+	if err := s.updateBlobRefTags(ctx, req.Did, req.Cid, "tags - ?", req.Tags); err != nil {
+		if err != gocql.ErrNotFound {
+			logger.Error("failed to remove blob ref tags", "err", err)
+		}
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.RemoveBlobRefTagsResponse{}, nil
+}
+
+// Applies a set operation to a blob ref's tags. Only blob refs that exist are updated, so that tagging an unknown
+// blob doesn't create a blob ref for it, and gocql.ErrNotFound is returned if it doesn't.
+func (s *Server) updateBlobRefTags(ctx context.Context, did string, cid string, operation string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	exists, err := s.blobRefExists(ctx, did, cid)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return gocql.ErrNotFound
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE blob_refs
+		SET tags = %s, updated_at = ?
+		WHERE did = ? AND cid = ?
+	`, operation)
+
+	return s.cqlSession.Query(query, tags, time.Now().UTC(), did, cid).WithContext(ctx).Exec()
+}
+
+// Reports whether the CDN has seen a blob. Blob refs are never deleted, so a blob ref that exists can be updated
+// without a lightweight transaction, which would otherwise race with the plain writes that create and reference it.
+func (s *Server) blobRefExists(ctx context.Context, did string, cid string) (bool, error) {
+	var existingCid string
+	if err := s.readQuery(`
+		SELECT cid
+		FROM blob_refs
+		WHERE did = ? AND cid = ?
+	`, did, cid).WithContext(ctx).Scan(&existingCid); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
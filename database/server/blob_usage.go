@@ -0,0 +1,335 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+const (
+	// How often blobs that lost their last reference are checked for garbage collection
+	blobGCInterval = 10 * time.Minute
+	// Default for how long a blob must go unreferenced before it's orphaned. Records are commonly deleted and
+	// recreated, such as when a post is edited by deleting it, so blobs aren't orphaned as soon as they're unused.
+	defaultBlobGCGracePeriod = 24 * time.Hour
+)
+
+func (s *Server) SetRecordBlobUsages(ctx context.Context, req *vyletdatabase.SetRecordBlobUsagesRequest) (*vyletdatabase.SetRecordBlobUsagesResponse, error) {
+	logger := s.logger.With("name", "SetRecordBlobUsages", "did", req.Did, "recordUri", req.RecordUri)
+
+	iter := s.readQuery(`
+		SELECT cid
+		FROM blob_ref_usages_by_record
+		WHERE record_uri = ?
+	`, req.RecordUri).WithContext(ctx).Iter()
+
+	existing := make(map[string]struct{})
+	var cid string
+	for iter.Scan(&cid) {
+		existing[cid] = struct{}{}
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate record blob usages", "err", err)
+		return nil, databaseError(err)
+	}
+
+	wanted := make(map[string]struct{})
+	var added []string
+	for _, cid := range req.Cids {
+		if _, ok := wanted[cid]; ok {
+			continue
+		}
+		wanted[cid] = struct{}{}
+		if _, ok := existing[cid]; !ok {
+			added = append(added, cid)
+		}
+	}
+
+	var removed []string
+	for cid := range existing {
+		if _, ok := wanted[cid]; !ok {
+			removed = append(removed, cid)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return &vyletdatabase.SetRecordBlobUsagesResponse{}, nil
+	}
+
+	now := time.Now().UTC()
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, cid := range added {
+		batch.Query(`
+			INSERT INTO blob_ref_usages
+				(did, cid, record_uri, created_at)
+			VALUES
+				(?, ?, ?, ?)
+		`, req.Did, cid, req.RecordUri, now)
+
+		batch.Query(`
+			INSERT INTO blob_ref_usages_by_record
+				(record_uri, cid, did, created_at)
+			VALUES
+				(?, ?, ?, ?)
+		`, req.RecordUri, cid, req.Did, now)
+
+		batch.Query(`
+			DELETE FROM blob_gc_candidates
+			WHERE did = ? AND cid = ?
+		`, req.Did, cid)
+	}
+
+	for _, cid := range removed {
+		batch.Query(`
+			DELETE FROM blob_ref_usages
+			WHERE did = ? AND cid = ? AND record_uri = ?
+		`, req.Did, cid, req.RecordUri)
+
+		batch.Query(`
+			DELETE FROM blob_ref_usages_by_record
+			WHERE record_uri = ? AND cid = ?
+		`, req.RecordUri, cid)
+	}
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to update blob usages", "err", err)
+		return nil, databaseError(err)
+	}
+
+	if err := s.setBlobsReferenced(ctx, req.Did, added, true); err != nil {
+		logger.Error("failed to mark blobs referenced", "err", err)
+		return nil, databaseError(err)
+	}
+
+	// Blobs that lost their last reference become candidates for garbage collection
+	var unreferenced []string
+	for _, cid := range removed {
+		inUse, err := s.blobInUse(ctx, req.Did, cid)
+		if err != nil {
+			logger.Error("failed to check blob usages", "cid", cid, "err", err)
+			return nil, databaseError(err)
+		}
+		if !inUse {
+			unreferenced = append(unreferenced, cid)
+		}
+	}
+
+	if len(unreferenced) > 0 {
+		batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+		for _, cid := range unreferenced {
+			batch.Query(`
+				INSERT INTO blob_gc_candidates
+					(did, cid, unreferenced_at)
+				VALUES
+					(?, ?, ?)
+			`, req.Did, cid, now)
+		}
+		if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+			logger.Error("failed to add blob gc candidates", "err", err)
+			return nil, databaseError(err)
+		}
+
+		if err := s.setBlobsReferenced(ctx, req.Did, unreferenced, false); err != nil {
+			logger.Error("failed to mark blobs unreferenced", "err", err)
+			return nil, databaseError(err)
+		}
+	}
+
+	return &vyletdatabase.SetRecordBlobUsagesResponse{}, nil
+}
+
+// Returns whether any record still uses a blob
+func (s *Server) blobInUse(ctx context.Context, did string, cid string) (bool, error) {
+	var recordUri string
+	if err := s.readQuery(`
+		SELECT record_uri
+		FROM blob_ref_usages
+		WHERE did = ? AND cid = ?
+		LIMIT 1
+	`, did, cid).WithContext(ctx).Scan(&recordUri); err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Marks blobs as unreferenced when their last record stops using them, or as referenced again when a record uses
+// them. Referencing a blob also un-orphans it. Blobs the CDN hasn't seen are skipped, since an update would otherwise
+// create a blob ref for them.
+func (s *Server) setBlobsReferenced(ctx context.Context, did string, cids []string, referenced bool) error {
+	if len(cids) == 0 {
+		return nil
+	}
+
+	iter := s.readQuery(`
+		SELECT cid
+		FROM blob_refs
+		WHERE did = ? AND cid IN ?
+	`, did, cids).WithContext(ctx).Iter()
+
+	var (
+		existingCids []string
+		cid          string
+	)
+	for iter.Scan(&cid) {
+		existingCids = append(existingCids, cid)
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to iterate blob refs: %w", err)
+	}
+
+	if len(existingCids) == 0 {
+		return nil
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	for _, cid := range existingCids {
+		if referenced {
+			batch.Query(`
+				UPDATE blob_refs
+				SET unreferenced_at = null, orphaned = false, orphaned_at = null
+				WHERE did = ? AND cid = ?
+			`, did, cid)
+		} else {
+			batch.Query(`
+				UPDATE blob_refs
+				SET unreferenced_at = ?
+				WHERE did = ? AND cid = ?
+			`, time.Now().UTC(), did, cid)
+		}
+	}
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to update blob refs: %w", err)
+	}
+
+	return nil
+}
+
+// Periodically garbage collects unreferenced blobs until the context is cancelled. Like post deletion jobs, replicas
+// lease shards of the candidates table, so each candidate is only collected by one replica at a time.
+func (s *Server) runBlobGC(ctx context.Context) {
+	logger := s.logger.With("name", "runBlobGC")
+
+	ticker := time.NewTicker(blobGCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.collectBlobs(ctx); err != nil {
+			logger.Error("failed to collect blobs", "err", err)
+		}
+	}
+}
+
+// Orphans candidate blobs that are still unreferenced after the grace period, so that the blob proxy stops serving
+// them. Candidates that have been referenced again are dropped.
+func (s *Server) collectBlobs(ctx context.Context) error {
+	var trackedSince time.Time
+	if err := s.readQuery(`
+		SELECT started_at
+		FROM blob_usage_tracking
+		WHERE id = 0
+	`).WithContext(ctx).Scan(&trackedSince); err != nil {
+		return fmt.Errorf("failed to get when blob usage tracking started: %w", err)
+	}
+
+	return s.forEachJobPartition(ctx, "blob_gc", "blob_gc_candidates", "did", func(ctx context.Context, did string) {
+		s.collectActorBlobs(ctx, did, trackedSince)
+	})
+}
+
+// Garbage collects one actor's candidate blobs. Blobs the CDN first saw before blob usages were tracked are never
+// orphaned, since records written before then may still use them without having a usage.
+func (s *Server) collectActorBlobs(ctx context.Context, did string, trackedSince time.Time) {
+	logger := s.logger.With("name", "collectActorBlobs", "did", did)
+
+	type candidate struct {
+		cid            string
+		unreferencedAt time.Time
+	}
+
+	iter := s.readQuery(`
+		SELECT cid, unreferenced_at
+		FROM blob_gc_candidates
+		WHERE did = ?
+	`, did).WithContext(ctx).Iter()
+
+	var (
+		candidates []candidate
+		c          candidate
+	)
+	for iter.Scan(&c.cid, &c.unreferencedAt) {
+		candidates = append(candidates, c)
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate blob gc candidates", "err", err)
+		return
+	}
+
+	cutoff := time.Now().Add(-s.blobGCGracePeriod)
+	var orphaned int
+	for _, c := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		if c.unreferencedAt.After(cutoff) {
+			continue
+		}
+
+		inUse, err := s.blobInUse(ctx, did, c.cid)
+		if err != nil {
+			logger.Error("failed to check blob usages", "cid", c.cid, "err", err)
+			continue
+		}
+
+		if !inUse {
+			// Only blobs the CDN has seen are orphaned, so that the update can't create a blob ref
+			var firstSeenAt time.Time
+			err := s.readQuery(`
+				SELECT first_seen_at
+				FROM blob_refs
+				WHERE did = ? AND cid = ?
+			`, did, c.cid).WithContext(ctx).Scan(&firstSeenAt)
+			if err != nil && err != gocql.ErrNotFound {
+				logger.Error("failed to get blob ref", "cid", c.cid, "err", err)
+				continue
+			}
+
+			if err == nil && !firstSeenAt.Before(trackedSince) {
+				if err := s.cqlSession.Query(`
+					UPDATE blob_refs
+					SET orphaned = true, orphaned_at = ?
+					WHERE did = ? AND cid = ?
+				`, time.Now().UTC(), did, c.cid).WithContext(ctx).Exec(); err != nil {
+					logger.Error("failed to orphan blob", "cid", c.cid, "err", err)
+					continue
+				}
+				orphaned++
+			}
+		}
+
+		if err := s.cqlSession.Query(`
+			DELETE FROM blob_gc_candidates
+			WHERE did = ? AND cid = ?
+		`, did, c.cid).WithContext(ctx).Exec(); err != nil {
+			logger.Error("failed to delete blob gc candidate", "cid", c.cid, "err", err)
+		}
+	}
+
+	if orphaned > 0 {
+		logger.Info("orphaned unreferenced blobs", "count", orphaned)
+	}
+}
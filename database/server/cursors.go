@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/vylet-app/go/internal/cursor"
+	"github.com/vylet-app/go/internal/helpers"
+)
+
+// Returns the scope for cursors over a listing, so that a cursor issued for one listing can't be used to page
+// through another. Listings are identified by the table they read and the partition being read.
+func cursorScope(table string, partition string) string {
+	return table + "/" + partition
+}
+
+// Decodes a request's cursor. A nil or empty cursor means the first page, and is returned as nil.
+func (s *Server) decodeCursor(scope string, encoded *string) (*cursor.Cursor, error) {
+	if encoded == nil || *encoded == "" {
+		return nil, nil
+	}
+
+	cur, err := s.cursors.Decode(scope, *encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cur, nil
+}
+
+// Encodes the cursor for the page after a row, from the row's time and its tie breaking key
+func (s *Server) encodeCursor(scope string, t time.Time, key string) *string {
+	return helpers.ToStringPtr(s.cursors.Encode(scope, cursor.Cursor{
+		Time: t,
+		Key:  key,
+	}))
+}
+
+// The slices of a partition clustered by (created_at DESC, uri ASC) that make up a page
+type pageSliceKind int
+
+const (
+	// Every row, for the first page
+	pageSliceAll pageSliceKind = iota
+	// Rows created at the cursor's time with a uri after the cursor's
+	pageSliceTies
+	// Rows created before the cursor's time
+	pageSliceOlder
+)
+
+type pageSlice struct {
+	kind  pageSliceKind
+	cur   cursor.Cursor
+	limit int
+}
+
+// Returns the slice's restriction on the clustering columns, to be appended to a WHERE clause restricting the
+// partition key, along with the values bound to it
+func (sl pageSlice) restriction() (string, []any) {
+	switch sl.kind {
+	case pageSliceTies:
+		return " AND created_at = ? AND uri > ?", []any{sl.cur.Time, sl.cur.Key}
+	case pageSliceOlder:
+		return " AND created_at < ?", []any{sl.cur.Time}
+	}
+	return "", nil
+}
+
+// The subset of *gocql.Iter that pages are read with
+type rowIter interface {
+	Scan(dest ...any) bool
+	Close() error
+}
+
+// Iterates over a page of a partition clustered by (created_at DESC, uri ASC). A page after a cursor can't be read
+// with a (created_at, uri) < (?, ?) comparison, since tuples compare every column in ascending order, which doesn't
+// match the clustering order when rows share a created_at. Instead the page is read as the rows sharing the cursor's
+// created_at with a later uri, followed by the rows created before the cursor, so that rows with the same time are
+// neither skipped nor repeated.
+type pageIter struct {
+	open      func(sl pageSlice) rowIter
+	slices    []pageSlice
+	remaining int
+	iter      rowIter
+	err       error
+}
+
+func newPageIter(cur *cursor.Cursor, limit int, open func(sl pageSlice) rowIter) *pageIter {
+	it := &pageIter{
+		open:      open,
+		remaining: limit,
+	}
+
+	if cur == nil {
+		it.slices = []pageSlice{{kind: pageSliceAll}}
+	} else {
+		it.slices = []pageSlice{
+			{kind: pageSliceTies, cur: *cur},
+			{kind: pageSliceOlder, cur: *cur},
+		}
+	}
+
+	return it
+}
+
+// Scans the next row of the page into dest, moving on to the next slice once one runs out
+func (it *pageIter) Scan(dest ...any) bool {
+	for {
+		if it.iter == nil {
+			if it.err != nil || it.remaining <= 0 || len(it.slices) == 0 {
+				return false
+			}
+			sl := it.slices[0]
+			it.slices = it.slices[1:]
+			sl.limit = it.remaining
+			it.iter = it.open(sl)
+		}
+
+		if it.iter.Scan(dest...) {
+			it.remaining--
+			return true
+		}
+
+		err := it.iter.Close()
+		it.iter = nil
+		if err != nil {
+			it.err = err
+			return false
+		}
+	}
+}
+
+func (it *pageIter) Close() error {
+	if it.iter != nil {
+		if err := it.iter.Close(); err != nil && it.err == nil {
+			it.err = err
+		}
+		it.iter = nil
+	}
+	it.slices = nil
+	return it.err
+}
+
+// Reads up to limit rows of a partition clustered by (created_at DESC, uri ASC) after a cursor, or from the start
+// if the cursor is nil. selectFrom is the query's SELECT and FROM clauses, and partition is a WHERE condition
+// restricting the partition key, with partitionArgs bound to it.
+func (s *Server) readPage(ctx context.Context, selectFrom, partition string, partitionArgs []any, cur *cursor.Cursor, limit int) *pageIter {
+	return newPageIter(cur, limit, func(sl pageSlice) rowIter {
+		restriction, restrictionArgs := sl.restriction()
+
+		args := make([]any, 0, len(partitionArgs)+len(restrictionArgs)+1)
+		args = append(args, partitionArgs...)
+		args = append(args, restrictionArgs...)
+		args = append(args, sl.limit)
+
+		return s.readQuery(selectFrom+`
+			WHERE `+partition+restriction+`
+			ORDER BY created_at DESC, uri ASC
+			LIMIT ?
+		`, args...).WithContext(ctx).Iter()
+	})
+}
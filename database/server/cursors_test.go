@@ -0,0 +1,321 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vylet-app/go/internal/cursor"
+)
+
+type fakeRow struct {
+	createdAt time.Time
+	uri       string
+}
+
+// An in-memory partition clustered by (created_at DESC, uri ASC), read the way Cassandra would read each slice
+type fakePartition struct {
+	rows []fakeRow
+	// Error returned when closing an iterator over the given kind of slice
+	closeErrs map[pageSliceKind]error
+}
+
+func (p *fakePartition) insert(rows ...fakeRow) {
+	p.rows = append(p.rows, rows...)
+	slices.SortFunc(p.rows, func(a, b fakeRow) int {
+		if c := b.createdAt.Compare(a.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.uri, b.uri)
+	})
+}
+
+func (p *fakePartition) delete(uri string) {
+	p.rows = slices.DeleteFunc(p.rows, func(row fakeRow) bool {
+		return row.uri == uri
+	})
+}
+
+func (p *fakePartition) open(sl pageSlice) rowIter {
+	var rows []fakeRow
+	for _, row := range p.rows {
+		if len(rows) >= sl.limit {
+			break
+		}
+		switch sl.kind {
+		case pageSliceTies:
+			if !row.createdAt.Equal(sl.cur.Time) || row.uri <= sl.cur.Key {
+				continue
+			}
+		case pageSliceOlder:
+			if !row.createdAt.Before(sl.cur.Time) {
+				continue
+			}
+		}
+		rows = append(rows, row)
+	}
+	return &fakeIter{rows: rows, closeErr: p.closeErrs[sl.kind]}
+}
+
+type fakeIter struct {
+	rows     []fakeRow
+	closeErr error
+}
+
+func (it *fakeIter) Scan(dest ...any) bool {
+	if len(it.rows) == 0 {
+		return false
+	}
+	*dest[0].(*time.Time) = it.rows[0].createdAt
+	*dest[1].(*string) = it.rows[0].uri
+	it.rows = it.rows[1:]
+	return true
+}
+
+func (it *fakeIter) Close() error {
+	return it.closeErr
+}
+
+// Reads a page the way the server's listings do, reading one row more than the limit to tell whether there's a
+// next page
+func readFakePage(t *testing.T, s *Server, scope string, p *fakePartition, encoded *string, limit int) ([]string, *string) {
+	t.Helper()
+
+	cur, err := s.decodeCursor(scope, encoded)
+	if err != nil {
+		t.Fatalf("failed to decode cursor: %v", err)
+	}
+
+	iter := newPageIter(cur, limit+1, p.open)
+
+	var (
+		rows      []fakeRow
+		createdAt time.Time
+		uri       string
+	)
+	for iter.Scan(&createdAt, &uri) {
+		rows = append(rows, fakeRow{createdAt: createdAt, uri: uri})
+	}
+	if err := iter.Close(); err != nil {
+		t.Fatalf("failed to read page: %v", err)
+	}
+
+	var next *string
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next = s.encodeCursor(scope, last.createdAt, last.uri)
+	}
+
+	uris := make([]string, 0, len(rows))
+	for _, row := range rows {
+		uris = append(uris, row.uri)
+	}
+	return uris, next
+}
+
+// Reads every page of a partition, calling between after each page
+func readAllFakePages(t *testing.T, s *Server, p *fakePartition, limit int, between func(page int)) []string {
+	t.Helper()
+
+	scope := cursorScope("likes_by_subject", "at://did:plc:abc/app.vylet.feed.post/3k")
+
+	var (
+		all  []string
+		next *string
+	)
+	for page := 0; ; page++ {
+		if page > 100 {
+			t.Fatal("pagination didn't finish")
+		}
+		uris, cur := readFakePage(t, s, scope, p, next, limit)
+		all = append(all, uris...)
+		if cur == nil {
+			return all
+		}
+		next = cur
+		if between != nil {
+			between(page)
+		}
+	}
+}
+
+func newTestServer() *Server {
+	return &Server{
+		cursors: cursor.NewCodec([]byte("secret")),
+	}
+}
+
+func rowsAt(createdAt time.Time, uris ...string) []fakeRow {
+	rows := make([]fakeRow, 0, len(uris))
+	for _, uri := range uris {
+		rows = append(rows, fakeRow{createdAt: createdAt, uri: uri})
+	}
+	return rows
+}
+
+func TestPaginationTies(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p := &fakePartition{}
+	p.insert(rowsAt(t0.Add(2*time.Second), "a")...)
+	p.insert(rowsAt(t0.Add(time.Second), "b", "c", "d", "e", "f", "g", "h")...)
+	p.insert(rowsAt(t0, "i", "j")...)
+
+	want := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+
+	for limit := 1; limit <= len(want)+1; limit++ {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			got := readAllFakePages(t, newTestServer(), p, limit, nil)
+			if !slices.Equal(got, want) {
+				t.Fatalf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPaginationDeletesBetweenPages(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newPartition := func() *fakePartition {
+		p := &fakePartition{}
+		p.insert(rowsAt(t0.Add(time.Second), "a", "b", "c", "d")...)
+		p.insert(rowsAt(t0, "e", "f", "g")...)
+		return p
+	}
+
+	tests := []struct {
+		name string
+		// Rows deleted after each page, by page
+		deletes map[int][]string
+		want    []string
+	}{
+		{
+			// The first page ends at "b", which is then deleted. The next page still starts after it.
+			name:    "row at cursor",
+			deletes: map[int][]string{0: {"b"}},
+			want:    []string{"a", "b", "c", "d", "e", "f", "g"},
+		},
+		{
+			name:    "rows already read",
+			deletes: map[int][]string{0: {"a"}, 1: {"c"}},
+			want:    []string{"a", "b", "c", "d", "e", "f", "g"},
+		},
+		{
+			name:    "rows not yet read",
+			deletes: map[int][]string{0: {"c", "e"}},
+			want:    []string{"a", "b", "d", "f", "g"},
+		},
+		{
+			name:    "every tie after the cursor",
+			deletes: map[int][]string{0: {"b", "c", "d"}},
+			want:    []string{"a", "b", "e", "f", "g"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPartition()
+			got := readAllFakePages(t, newTestServer(), p, 2, func(page int) {
+				for _, uri := range tt.deletes[page] {
+					p.delete(uri)
+				}
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginationRejectsTamperedCursors(t *testing.T) {
+	s := newTestServer()
+	scope := cursorScope("posts_by_actor", "did:plc:abc")
+	encoded := s.encodeCursor(scope, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "at://did:plc:abc/app.vylet.feed.post/3k")
+
+	tampered := []byte(*encoded)
+	if tampered[len(tampered)-1] == 'A' {
+		tampered[len(tampered)-1] = 'B'
+	} else {
+		tampered[len(tampered)-1] = 'A'
+	}
+
+	tests := []struct {
+		name    string
+		scope   string
+		encoded string
+	}{
+		{name: "tampered", scope: scope, encoded: string(tampered)},
+		{name: "other listing", scope: cursorScope("posts_by_actor", "did:plc:def"), encoded: *encoded},
+		{name: "other table", scope: cursorScope("likes_by_actor", "did:plc:abc"), encoded: *encoded},
+		{name: "other secret", scope: scope, encoded: *(&Server{cursors: cursor.NewCodec([]byte("other"))}).encodeCursor(scope, time.Now(), "x")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.decodeCursor(tt.scope, &tt.encoded); !errors.Is(err, cursor.ErrInvalidCursor) {
+				t.Fatalf("got err %v, want %v", err, cursor.ErrInvalidCursor)
+			}
+		})
+	}
+
+	empty := ""
+	for _, encoded := range []*string{nil, &empty} {
+		cur, err := s.decodeCursor(scope, encoded)
+		if err != nil || cur != nil {
+			t.Fatalf("got %v, %v for an empty cursor, want the first page", cur, err)
+		}
+	}
+}
+
+func TestPageIterCloseErrors(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	errRead := errors.New("read failed")
+
+	p := &fakePartition{closeErrs: map[pageSliceKind]error{pageSliceTies: errRead}}
+	p.insert(rowsAt(t0, "a", "b")...)
+	p.insert(rowsAt(t0.Add(-time.Second), "c")...)
+
+	iter := newPageIter(&cursor.Cursor{Time: t0, Key: "a"}, 10, p.open)
+
+	var (
+		createdAt time.Time
+		uri       string
+		uris      []string
+	)
+	for iter.Scan(&createdAt, &uri) {
+		uris = append(uris, uri)
+	}
+
+	// The older slice isn't read once the ties slice fails
+	if !slices.Equal(uris, []string{"b"}) {
+		t.Fatalf("got %v, want [b]", uris)
+	}
+	if err := iter.Close(); !errors.Is(err, errRead) {
+		t.Fatalf("got err %v, want %v", err, errRead)
+	}
+}
+
+func TestPageSliceRestriction(t *testing.T) {
+	cur := cursor.Cursor{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Key: "at://did:plc:abc/app.vylet.feed.post/3k"}
+
+	tests := []struct {
+		kind     pageSliceKind
+		want     string
+		wantArgs []any
+	}{
+		{kind: pageSliceAll, want: ""},
+		{kind: pageSliceTies, want: " AND created_at = ? AND uri > ?", wantArgs: []any{cur.Time, cur.Key}},
+		{kind: pageSliceOlder, want: " AND created_at < ?", wantArgs: []any{cur.Time}},
+	}
+
+	for _, tt := range tests {
+		got, args := pageSlice{kind: tt.kind, cur: cur}.restriction()
+		if got != tt.want || !slices.Equal(args, tt.wantArgs) {
+			t.Fatalf("got %q %v for kind %d, want %q %v", got, args, tt.kind, tt.want, tt.wantArgs)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain of the ErrorInfo details attached to returned errors
+const errorDomain = "database.vylet.app"
+
+// Builds a status error with an ErrorInfo detail giving the reason for the error
+func statusError(code codes.Code, reason vyletdatabase.ErrorReason, msg string) error {
+	st := status.New(code, msg)
+	if withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason.String(),
+		Domain: errorDomain,
+	}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+func notFoundError(msg string) error {
+	return statusError(codes.NotFound, vyletdatabase.ErrorReason_ERROR_REASON_NOT_FOUND, msg)
+}
+
+func invalidArgumentError(format string, args ...any) error {
+	return statusError(codes.InvalidArgument, vyletdatabase.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, fmt.Sprintf(format, args...))
+}
+
+func invalidCursorError() error {
+	return statusError(codes.InvalidArgument, vyletdatabase.ErrorReason_ERROR_REASON_INVALID_CURSOR, "invalid cursor")
+}
+
+// Converts an error from Cassandra into a status error. Errors that mean Cassandra couldn't serve the request right
+// now are Unavailable or DeadlineExceeded, so that clients know they can be retried, and anything else is Internal.
+func databaseError(err error) error {
+	var (
+		unavailable  *gocql.RequestErrUnavailable
+		readTimeout  *gocql.RequestErrReadTimeout
+		writeTimeout *gocql.RequestErrWriteTimeout
+	)
+
+	switch {
+	case errors.Is(err, gocql.ErrNotFound):
+		return notFoundError("not found")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, gocql.ErrTimeoutNoResponse),
+		errors.As(err, &readTimeout),
+		errors.As(err, &writeTimeout):
+		return statusError(codes.DeadlineExceeded, vyletdatabase.ErrorReason_ERROR_REASON_DATABASE_TIMEOUT, err.Error())
+	case errors.Is(err, gocql.ErrNoConnections),
+		errors.Is(err, gocql.ErrConnectionClosed),
+		errors.Is(err, gocql.ErrSessionClosed),
+		errors.Is(err, gocql.ErrUnavailable),
+		errors.As(err, &unavailable):
+		return statusError(codes.Unavailable, vyletdatabase.ErrorReason_ERROR_REASON_DATABASE_UNAVAILABLE, err.Error())
+	default:
+		return statusError(codes.Internal, vyletdatabase.ErrorReason_ERROR_REASON_INTERNAL, err.Error())
+	}
+}
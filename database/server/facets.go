@@ -0,0 +1,90 @@
+package server
+
+import (
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+// Mirrors the facet_feature UDT. Exactly one of the fields is set.
+type cqlFacetFeature struct {
+	Mention *string `cql:"mention"`
+	Link    *string `cql:"link"`
+	Tag     *string `cql:"tag"`
+}
+
+// Mirrors the facet UDT
+type cqlFacet struct {
+	ByteStart int64             `cql:"byte_start"`
+	ByteEnd   int64             `cql:"byte_end"`
+	Features  []cqlFacetFeature `cql:"features"`
+}
+
+func facetsToCql(facets []*vyletdatabase.Facet) []cqlFacet {
+	if len(facets) == 0 {
+		return nil
+	}
+
+	out := make([]cqlFacet, 0, len(facets))
+	for _, facet := range facets {
+		features := make([]cqlFacetFeature, 0, len(facet.Features))
+		for _, feature := range facet.Features {
+			switch f := feature.Feature.(type) {
+			case *vyletdatabase.FacetFeature_Mention:
+				features = append(features, cqlFacetFeature{Mention: &f.Mention})
+			case *vyletdatabase.FacetFeature_Link:
+				features = append(features, cqlFacetFeature{Link: &f.Link})
+			case *vyletdatabase.FacetFeature_Tag:
+				features = append(features, cqlFacetFeature{Tag: &f.Tag})
+			}
+		}
+		out = append(out, cqlFacet{
+			ByteStart: facet.ByteStart,
+			ByteEnd:   facet.ByteEnd,
+			Features:  features,
+		})
+	}
+
+	return out
+}
+
+func facetsFromCql(facets []cqlFacet) []*vyletdatabase.Facet {
+	if len(facets) == 0 {
+		return nil
+	}
+
+	out := make([]*vyletdatabase.Facet, 0, len(facets))
+	for _, facet := range facets {
+		features := make([]*vyletdatabase.FacetFeature, 0, len(facet.Features))
+		for _, feature := range facet.Features {
+			switch {
+			case feature.Mention != nil:
+				features = append(features, &vyletdatabase.FacetFeature{
+					Feature: &vyletdatabase.FacetFeature_Mention{Mention: *feature.Mention},
+				})
+			case feature.Link != nil:
+				features = append(features, &vyletdatabase.FacetFeature{
+					Feature: &vyletdatabase.FacetFeature_Link{Link: *feature.Link},
+				})
+			case feature.Tag != nil:
+				features = append(features, &vyletdatabase.FacetFeature{
+					Feature: &vyletdatabase.FacetFeature_Tag{Tag: *feature.Tag},
+				})
+			}
+		}
+		out = append(out, &vyletdatabase.Facet{
+			ByteStart: facet.ByteStart,
+			ByteEnd:   facet.ByteEnd,
+			Features:  features,
+		})
+	}
+
+	return out
+}
+
+// Posts written before facets were stored typed only have the legacy JSON column, which is returned as is for the
+// reader to decode.
+func setPostFacets(post *vyletdatabase.Post, typedFacets []cqlFacet) {
+	if len(typedFacets) > 0 {
+		post.Facets = facetsFromCql(typedFacets)
+		post.LegacyFacets = nil
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/gocql/gocql"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/internal/helpers"
+)
+
+func (s *Server) CreateFollow(ctx context.Context, req *vyletdatabase.CreateFollowRequest) (*vyletdatabase.CreateFollowResponse, error) {
+	logger := s.logger.With("name", "CreateFollow")
+
+	aturi, err := syntax.ParseATURI(req.Follow.Uri)
+	if err != nil {
+		return nil, invalidArgumentError("invalid uri: %v", err)
+	}
+
+	did := aturi.Authority().String()
+	now := time.Now().UTC()
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	followArgs := []any{
+		req.Follow.Uri,
+		req.Follow.Cid,
+		req.Follow.SubjectDid,
+		did,
+		req.Follow.CreatedAt.AsTime(),
+		now,
+	}
+
+	followQuery := `
+		INSERT INTO %s
+			(uri, cid, subject_did, author_did, created_at, indexed_at)
+		VALUES
+			(?, ?, ?, ?, ?, ?)
+	`
+
+	batch.Query(fmt.Sprintf(followQuery, "follows_by_uri"), followArgs...)
+	batch.Query(fmt.Sprintf(followQuery, "follows_by_actor"), followArgs...)
+	batch.Query(fmt.Sprintf(followQuery, "follows_by_subject"), followArgs...)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to create follow", "uri", req.Follow.Uri, "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.CreateFollowResponse{}, nil
+}
+
+func (s *Server) DeleteFollow(ctx context.Context, req *vyletdatabase.DeleteFollowRequest) (*vyletdatabase.DeleteFollowResponse, error) {
+	logger := s.logger.With("name", "DeleteFollow", "uri", req.Uri)
+
+	var (
+		subjectDid string
+		authorDid  string
+	)
+
+	query := `
+		SELECT subject_did, author_did
+		FROM follows_by_uri
+		WHERE uri = ?
+	`
+	if err := s.readQuery(query, req.Uri).WithContext(ctx).Scan(&subjectDid, &authorDid); err != nil {
+		if err == gocql.ErrNotFound {
+			logger.Warn("follow not found", "uri", req.Uri)
+			return nil, notFoundError("follow not found")
+		}
+		logger.Error("failed to fetch follow", "uri", req.Uri, "err", err)
+		return nil, databaseError(err)
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	batch.Query(`
+		DELETE FROM follows_by_uri
+		WHERE uri = ?
+	`, req.Uri)
+
+	batch.Query(`
+		DELETE FROM follows_by_actor
+		WHERE author_did = ? AND subject_did = ? AND uri = ?
+	`, authorDid, subjectDid, req.Uri)
+
+	batch.Query(`
+		DELETE FROM follows_by_subject
+		WHERE subject_did = ? AND author_did = ? AND uri = ?
+	`, subjectDid, authorDid, req.Uri)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to delete follow", "uri", req.Uri, "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.DeleteFollowResponse{}, nil
+}
+
+func (s *Server) GetFollowRelationships(ctx context.Context, req *vyletdatabase.GetFollowRelationshipsRequest) (*vyletdatabase.GetFollowRelationshipsResponse, error) {
+	logger := s.logger.With("name", "GetFollowRelationships", "did", req.Did)
+
+	relationships := make(map[string]*vyletdatabase.FollowRelationship)
+	if len(req.OtherDids) == 0 {
+		return &vyletdatabase.GetFollowRelationshipsResponse{
+			Relationships: relationships,
+		}, nil
+	}
+
+	relationship := func(did string) *vyletdatabase.FollowRelationship {
+		rel, ok := relationships[did]
+		if !ok {
+			rel = &vyletdatabase.FollowRelationship{}
+			relationships[did] = rel
+		}
+		return rel
+	}
+
+	// An account may have more than one follow record for the same subject, in which case any of them is reported
+	iter := s.readQuery(`
+		SELECT subject_did, uri
+		FROM follows_by_actor
+		WHERE author_did = ? AND subject_did IN ?
+	`, req.Did, req.OtherDids).WithContext(ctx).Iter()
+
+	var (
+		did string
+		uri string
+	)
+	for iter.Scan(&did, &uri) {
+		relationship(did).Following = helpers.ToStringPtr(uri)
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate follows by actor", "err", err)
+		return nil, databaseError(err)
+	}
+
+	iter = s.readQuery(`
+		SELECT author_did, uri
+		FROM follows_by_subject
+		WHERE subject_did = ? AND author_did IN ?
+	`, req.Did, req.OtherDids).WithContext(ctx).Iter()
+
+	for iter.Scan(&did, &uri) {
+		relationship(did).FollowedBy = helpers.ToStringPtr(uri)
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate follows by subject", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.GetFollowRelationshipsResponse{
+		Relationships: relationships,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// How often the Cassandra session is checked
+	healthCheckInterval = 5 * time.Second
+	// How long a Cassandra health check may take before the session is considered unhealthy
+	healthCheckTimeout = 2 * time.Second
+	// Default for how long in flight RPCs are given to finish on shutdown before they're cancelled
+	defaultShutdownTimeout = 30 * time.Second
+)
+
+// Tracks whether the server can serve requests, for both the gRPC health service that clients use to pick replicas
+// and the HTTP readiness endpoint
+type healthState struct {
+	server *health.Server
+
+	// Whether the last Cassandra health check succeeded
+	cassandraHealthy atomic.Bool
+	// Whether the schema has every migration the server was built with
+	schemaReady atomic.Bool
+	// Set once the server starts shutting down, after which it's never ready again
+	draining atomic.Bool
+}
+
+func newHealthState() *healthState {
+	h := &healthState{
+		server: health.NewServer(),
+	}
+	// Nothing is served until the first Cassandra health check passes
+	h.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return h
+}
+
+func (h *healthState) ready() bool {
+	return h.cassandraHealthy.Load() && h.schemaReady.Load() && !h.draining.Load()
+}
+
+// Updates the serving status of the server and each of its services
+func (h *healthState) update(services []string) {
+	st := healthpb.HealthCheckResponse_NOT_SERVING
+	if h.ready() {
+		st = healthpb.HealthCheckResponse_SERVING
+	}
+
+	h.server.SetServingStatus("", st)
+	for _, service := range services {
+		h.server.SetServingStatus(service, st)
+	}
+}
+
+// Marks the server as shutting down, so that clients stop sending it new requests
+func (h *healthState) drain() {
+	h.draining.Store(true)
+	h.server.Shutdown()
+}
+
+// Periodically checks the Cassandra session and updates the server's health until the context is cancelled
+func (s *Server) runHealthChecks(ctx context.Context) {
+	logger := s.logger.With("name", "runHealthChecks")
+
+	services := make([]string, 0)
+	for service := range s.grpcServer.GetServiceInfo() {
+		services = append(services, service)
+	}
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		healthy := s.checkCassandra(ctx)
+		if wasHealthy := s.health.cassandraHealthy.Swap(healthy); wasHealthy != healthy {
+			if healthy {
+				logger.Info("cassandra session is healthy")
+			} else {
+				logger.Warn("cassandra session is unhealthy")
+			}
+		}
+		if !s.health.draining.Load() {
+			s.health.update(services)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) checkCassandra(ctx context.Context) bool {
+	if s.cqlSession.Closed() {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var releaseVersion string
+	if err := s.cqlSession.Query(`
+		SELECT release_version
+		FROM system.local
+	`).WithContext(ctx).Scan(&releaseVersion); err != nil {
+		s.logger.Debug("cassandra health check failed", "err", err)
+		return false
+	}
+
+	return true
+}
+
+// Returns the handler for the liveness and readiness endpoints. Liveness only reports that the process is up, so that
+// it isn't restarted while Cassandra is unavailable or migrating, while readiness also requires a healthy Cassandra
+// session and an up to date schema, and fails once the server starts draining.
+func (s *Server) healthHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /livez", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !s.health.ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("not ready"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+
+	return mux
+}
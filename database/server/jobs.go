@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	// Number of token ranges each background job's table is split into. Replicas lease shards rather than the whole
+	// table, so that several replicas can share a job without working on the same rows.
+	jobShards = 16
+	// Number of partitions read from a job's table at a time
+	jobPageSize = 100
+	// How long a shard lease lasts. A replica that dies without releasing its leases only holds them this long.
+	jobLeaseTTL = 2 * time.Minute
+	// How long a replica works on a shard before leaving the rest for a later run, which is well within the lease
+	// so that the lease can't expire while the shard is still being worked on
+	jobShardTimeout = jobLeaseTTL / 2
+)
+
+// Returns the name identifying a replica in the leases it holds
+func jobLeaseOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return hostname + "/" + gocql.TimeUUID().String()
+}
+
+// Returns the token range of a shard, which covers tokens greater than start and no greater than end. The minimum
+// token is never assigned to a partition, so the first shard's exclusive start doesn't miss any.
+func jobShardRange(shard int) (int64, int64) {
+	// Tokens are offset from the minimum token as unsigned integers, which wrap around to the signed token
+	const minToken = uint64(1) << 63
+	step := uint64(math.MaxUint64)/jobShards + 1
+
+	start := int64(minToken + uint64(shard)*step)
+	if shard == jobShards-1 {
+		return start, math.MaxInt64
+	}
+	return start, int64(minToken + uint64(shard+1)*step)
+}
+
+// Tries to lease a shard of a job, returning whether it was leased
+func (s *Server) acquireJobLease(ctx context.Context, job string, shard int) (bool, error) {
+	applied, err := s.cqlSession.Query(`
+		INSERT INTO job_leases
+			(job, shard, owner, acquired_at)
+		VALUES
+			(?, ?, ?, ?)
+		IF NOT EXISTS
+		USING TTL ?
+	`, job, shard, s.jobLeaseOwner, time.Now().UTC(), int(jobLeaseTTL.Seconds())).WithContext(ctx).MapScanCAS(make(map[string]any))
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire job lease: %w", err)
+	}
+
+	return applied, nil
+}
+
+// Releases a lease, unless it expired and was taken by another replica
+func (s *Server) releaseJobLease(ctx context.Context, job string, shard int) error {
+	if _, err := s.cqlSession.Query(`
+		DELETE FROM job_leases
+		WHERE job = ? AND shard = ?
+		IF owner = ?
+	`, job, shard, s.jobLeaseOwner).WithContext(ctx).MapScanCAS(make(map[string]any)); err != nil {
+		return fmt.Errorf("failed to release job lease: %w", err)
+	}
+
+	return nil
+}
+
+// Calls fn with the partition key of each partition of a job's table, a page at a time, in every shard that no other
+// replica holds a lease on. Partitions that aren't reached before a shard's timeout are left for a later run, so fn
+// must be idempotent.
+func (s *Server) forEachJobPartition(ctx context.Context, job string, table string, partitionKey string, fn func(ctx context.Context, key string)) error {
+	shards := make([]int, jobShards)
+	for i := range shards {
+		shards[i] = i
+	}
+
+	return s.forEachJobShard(ctx, job, shards, func(ctx context.Context, shard int) error {
+		start, end := jobShardRange(shard)
+		return s.scanJobShard(ctx, table, partitionKey, start, end, fn, nil)
+	})
+}
+
+// Like forEachJobPartition, but each partition is only visited once across runs and replicas. Progress through each
+// shard is recorded in the job_progress table after every page, so a shard too big to finish before its timeout is
+// resumed where it was left on a later run, and shards that are done aren't scanned again until the job's progress
+// is reset.
+func (s *Server) forEachJobPartitionOnce(ctx context.Context, job string, table string, partitionKey string, fn func(ctx context.Context, key string)) error {
+	progress, err := s.jobProgress(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	var pending []int
+	for shard := range jobShards {
+		if !progress[shard].done {
+			pending = append(pending, shard)
+		}
+	}
+
+	return s.forEachJobShard(ctx, job, pending, func(ctx context.Context, shard int) error {
+		// Progress is read again under the lease, since another replica may have moved it on since it was last read
+		progress, err := s.jobProgress(ctx, job)
+		if err != nil {
+			return err
+		}
+
+		start, end := jobShardRange(shard)
+		if p, ok := progress[shard]; ok {
+			if p.done {
+				return nil
+			}
+			start = p.after
+		}
+
+		return s.scanJobShard(ctx, table, partitionKey, start, end, fn, func(after int64, done bool, startedAt time.Time) error {
+			return s.saveJobProgress(ctx, job, shard, after, done, startedAt)
+		})
+	})
+}
+
+// Calls work for each of the given shards of a job that no other replica holds a lease on, holding the lease while it
+// runs. Shards are visited from a random one, so that replicas starting together spread out.
+func (s *Server) forEachJobShard(ctx context.Context, job string, shards []int, work func(ctx context.Context, shard int) error) error {
+	logger := s.logger.With("name", "forEachJobShard", "job", job)
+
+	if len(shards) == 0 {
+		return nil
+	}
+
+	offset := rand.IntN(len(shards))
+	for i := range shards {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		shard := shards[(offset+i)%len(shards)]
+
+		leased, err := s.acquireJobLease(ctx, job, shard)
+		if err != nil {
+			return err
+		}
+		if !leased {
+			continue
+		}
+
+		shardCtx, cancel := context.WithTimeout(ctx, jobShardTimeout)
+		err = work(shardCtx, shard)
+		cancel()
+
+		// The lease is released even if the job's context was cancelled, rather than left to expire
+		releaseCtx, releaseCancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		if releaseErr := s.releaseJobLease(releaseCtx, job, shard); releaseErr != nil {
+			logger.Warn("failed to release job lease, it will expire on its own", "shard", shard, "err", releaseErr)
+		}
+		releaseCancel()
+
+		if err != nil && shardCtx.Err() == nil {
+			return fmt.Errorf("failed to scan shard %d: %w", shard, err)
+		}
+	}
+
+	return nil
+}
+
+// Calls fn with the partition key of each partition of a table with a token greater than after and no greater than
+// end, a page at a time. If checkpoint is set it's called after each page with the last token visited, whether the
+// range is done, and when the page started to be read.
+func (s *Server) scanJobShard(ctx context.Context, table string, partitionKey string, after int64, end int64, fn func(ctx context.Context, key string), checkpoint func(after int64, done bool, startedAt time.Time) error) error {
+	for ctx.Err() == nil {
+		startedAt := time.Now().UTC()
+
+		iter := s.readQuery(fmt.Sprintf(`
+			SELECT DISTINCT token(%[2]s), %[2]s
+			FROM %[1]s
+			WHERE token(%[2]s) > ? AND token(%[2]s) <= ?
+			LIMIT ?
+		`, table, partitionKey), after, end, jobPageSize).WithContext(ctx).Iter()
+
+		var (
+			keys  []string
+			token int64
+			key   string
+		)
+		for iter.Scan(&token, &key) {
+			keys = append(keys, key)
+			after = token
+		}
+		if err := iter.Close(); err != nil {
+			return fmt.Errorf("failed to iterate %s: %w", table, err)
+		}
+
+		for _, key := range keys {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fn(ctx, key)
+		}
+
+		done := len(keys) < jobPageSize
+		if checkpoint != nil {
+			if err := checkpoint(after, done, startedAt); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+type jobShardProgress struct {
+	// Token of the last partition visited
+	after int64
+	done  bool
+}
+
+// Returns how far a job that visits each partition once has got through each of its shards
+func (s *Server) jobProgress(ctx context.Context, job string) (map[int]jobShardProgress, error) {
+	iter := s.readQuery(`
+		SELECT shard, after_token, done
+		FROM job_progress
+		WHERE job = ?
+	`, job).WithContext(ctx).Iter()
+
+	progress := make(map[int]jobShardProgress, jobShards)
+	var (
+		shard int
+		p     jobShardProgress
+	)
+	for iter.Scan(&shard, &p.after, &p.done) {
+		progress[shard] = p
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate job progress: %w", err)
+	}
+
+	return progress, nil
+}
+
+// Records progress through a shard. The write is timestamped with when the page it follows started to be read, so
+// that a reset made after that is never overwritten by it.
+func (s *Server) saveJobProgress(ctx context.Context, job string, shard int, after int64, done bool, startedAt time.Time) error {
+	if err := s.cqlSession.Query(`
+		INSERT INTO job_progress
+			(job, shard, after_token, done)
+		VALUES
+			(?, ?, ?, ?)
+		USING TIMESTAMP ?
+	`, job, shard, after, done, startedAt.UnixMicro()).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to save job progress: %w", err)
+	}
+
+	return nil
+}
+
+// Clears a job's progress, so that every partition is visited again
+func (s *Server) resetJobProgress(ctx context.Context, job string) error {
+	if err := s.cqlSession.Query(`
+		DELETE FROM job_progress
+		WHERE job = ?
+	`, job).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to reset job progress: %w", err)
+	}
+
+	return nil
+}
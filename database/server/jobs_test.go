@@ -0,0 +1,28 @@
+package server
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJobShardRangesCoverEveryToken(t *testing.T) {
+	start, _ := jobShardRange(0)
+	if start != math.MinInt64 {
+		t.Fatalf("first shard starts after %d, want %d", start, int64(math.MinInt64))
+	}
+
+	for shard := 1; shard < jobShards; shard++ {
+		_, prevEnd := jobShardRange(shard - 1)
+		start, end := jobShardRange(shard)
+		if start != prevEnd {
+			t.Fatalf("shard %d starts after %d, want the previous shard's end %d", shard, start, prevEnd)
+		}
+		if end <= start {
+			t.Fatalf("shard %d ends at %d, before it starts after %d", shard, end, start)
+		}
+	}
+
+	if _, end := jobShardRange(jobShards - 1); end != math.MaxInt64 {
+		t.Fatalf("last shard ends at %d, want %d", end, int64(math.MaxInt64))
+	}
+}
@@ -3,13 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/gocql/gocql"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
-	"github.com/vylet-app/go/internal/helpers"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -18,7 +16,7 @@ func (s *Server) CreateLike(ctx context.Context, req *vyletdatabase.CreateLikeRe
 
 	aturi, err := syntax.ParseATURI(req.Like.Uri)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse aturi: %w", err)
+		return nil, invalidArgumentError("invalid uri: %v", err)
 	}
 
 	did := aturi.Authority().String()
@@ -46,23 +44,16 @@ func (s *Server) CreateLike(ctx context.Context, req *vyletdatabase.CreateLikeRe
 	batch.Query(fmt.Sprintf(likeQuery, "likes_by_subject"), likeArgs...)
 	batch.Query(fmt.Sprintf(likeQuery, "likes_by_actor"), likeArgs...)
 	batch.Query(fmt.Sprintf(likeQuery, "likes_by_uri"), likeArgs...)
+	s.addLikeCountUpdate(batch, req.Like.SubjectUri, now)
 
 	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
 		logger.Error("failed to create like", "uri", req.Like.Uri, "err", err)
-		return &vyletdatabase.CreateLikeResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
-	if err := s.cqlSession.Query(`
-		UPDATE post_interaction_counts
-		SET like_count = like_count + 1
-		WHERE post_uri = ?
-	`, req.Like.SubjectUri).WithContext(ctx).Exec(); err != nil {
+	if err := s.updateLikeCount(ctx, req.Like.SubjectUri, 1); err != nil {
 		logger.Error("failed to increment like count", "subject_uri", req.Like.SubjectUri, "err", err)
-		return &vyletdatabase.CreateLikeResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.CreateLikeResponse{}, nil
@@ -82,17 +73,13 @@ func (s *Server) DeleteLike(ctx context.Context, req *vyletdatabase.DeleteLikeRe
 		FROM likes_by_uri
 		WHERE uri = ?
 	`
-	if err := s.cqlSession.Query(query, req.Uri).WithContext(ctx).Scan(&createdAt, &subjectUri, &authorDid); err != nil {
+	if err := s.readQuery(query, req.Uri).WithContext(ctx).Scan(&createdAt, &subjectUri, &authorDid); err != nil {
 		if err == gocql.ErrNotFound {
 			logger.Warn("like not found", "uri", req.Uri)
-			return &vyletdatabase.DeleteLikeResponse{
-				Error: helpers.ToStringPtr("like not found"),
-			}, nil
+			return nil, notFoundError("like not found")
 		}
 		logger.Error("failed to fetch like", "uri", req.Uri, "err", err)
-		return &vyletdatabase.DeleteLikeResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
@@ -112,22 +99,16 @@ func (s *Server) DeleteLike(ctx context.Context, req *vyletdatabase.DeleteLikeRe
 		WHERE author_did = ? AND created_at = ? AND uri = ?
 	`, authorDid, createdAt, req.Uri)
 
+	s.addLikeCountUpdate(batch, subjectUri, time.Now().UTC())
+
 	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
 		logger.Error("failed to delete like", "uri", req.Uri, "err", err)
-		return &vyletdatabase.DeleteLikeResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
-	if err := s.cqlSession.Query(`
-		UPDATE post_interaction_counts
-		SET like_count = like_count - 1
-		WHERE post_uri = ?
-	`, subjectUri).WithContext(ctx).Exec(); err != nil {
-		logger.Error("failed to increment like count", "subject_uri", subjectUri, "err", err)
-		return &vyletdatabase.DeleteLikeResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+	if err := s.updateLikeCount(ctx, subjectUri, -1); err != nil {
+		logger.Error("failed to decrement like count", "subject_uri", subjectUri, "err", err)
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.DeleteLikeResponse{}, nil
@@ -137,52 +118,80 @@ func (s *Server) GetLikesBySubject(ctx context.Context, req *vyletdatabase.GetLi
 	logger := s.logger.With("name", "GetLikesBySubject", "subjectUri", req.SubjectUri)
 
 	if req.Limit <= 0 {
-		return nil, fmt.Errorf("limit must be greater than 0")
+		return nil, invalidArgumentError("limit must be greater than 0")
 	}
 
-	var (
-		query string
-		args  []any
-	)
+	scope := cursorScope("likes_by_subject", req.SubjectUri)
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
 
-	if req.Cursor != nil && *req.Cursor != "" {
-		cursorParts := strings.SplitN(*req.Cursor, "|", 2)
-		if len(cursorParts) != 2 {
-			logger.Error("invalid cursor format", "cursor", *req.Cursor)
-			return &vyletdatabase.GetLikesBySubjectResponse{
-				Error: helpers.ToStringPtr("invalid cursor format"),
-			}, nil
-		}
+	iter := s.readPage(ctx, `
+		SELECT uri, cid, subject_uri, subject_cid, author_did, created_at, indexed_at
+		FROM likes_by_subject
+	`, "subject_uri = ?", []any{req.SubjectUri}, cur, int(req.Limit)+1)
+	defer iter.Close()
 
-		cursorTime, err := time.Parse(time.RFC3339Nano, cursorParts[0])
-		if err != nil {
-			logger.Error("failed to parse cursor timestamp", "cursor", *req.Cursor, "err", err)
-			return &vyletdatabase.GetLikesBySubjectResponse{
-				Error: helpers.ToStringPtr("invalid cursor format"),
-			}, nil
+	var likes []*vyletdatabase.Like
+
+	var createdAt time.Time
+	var indexedAt time.Time
+	for {
+		like := &vyletdatabase.Like{}
+		if !iter.Scan(
+			&like.Uri,
+			&like.Cid,
+			&like.SubjectUri,
+			&like.SubjectCid,
+			&like.AuthorDid,
+			&createdAt,
+			&indexedAt,
+		) {
+			break
 		}
-		cursorUri := cursorParts[1]
-
-		query = `
-			SELECT uri, cid, subject_uri, subject_cid, author_did, created_at, indexed_at
-			FROM likes_by_subject
-			WHERE subject_uri = ? AND (created_at, uri) < (?, ?)
-			ORDER BY created_at DESC, uri ASC
-			LIMIT ?
-		`
-		args = []any{req.SubjectUri, cursorTime, cursorUri, req.Limit + 1}
-	} else {
-		query = `
-			SELECT uri, cid, subject_uri, subject_cid, author_did, created_at, indexed_at
-			FROM likes_by_subject
-			WHERE subject_uri = ?
-			ORDER BY created_at DESC, uri ASC
-			LIMIT ?
-		`
-		args = []any{req.SubjectUri, req.Limit}
-	}
-
-	iter := s.cqlSession.Query(query, args...).WithContext(ctx).Iter()
+		like.CreatedAt = timestamppb.New(createdAt)
+		like.IndexedAt = timestamppb.New(indexedAt)
+
+		likes = append(likes, like)
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate likes", "err", err)
+		return nil, databaseError(err)
+	}
+
+	var nextCursor *string
+	if len(likes) > int(req.Limit) {
+		likes = likes[:req.Limit]
+		lastLike := likes[len(likes)-1]
+		nextCursor = s.encodeCursor(scope, lastLike.CreatedAt.AsTime(), lastLike.Uri)
+	}
+
+	return &vyletdatabase.GetLikesBySubjectResponse{
+		Likes:  likes,
+		Cursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) GetLikesByActor(ctx context.Context, req *vyletdatabase.GetLikesByActorRequest) (*vyletdatabase.GetLikesByActorResponse, error) {
+	logger := s.logger.With("name", "GetLikesByActor", "did", req.Did)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	scope := cursorScope("likes_by_actor", req.Did)
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
+
+	iter := s.readPage(ctx, `
+		SELECT uri, cid, subject_uri, subject_cid, author_did, created_at, indexed_at
+		FROM likes_by_actor
+	`, "author_did = ?", []any{req.Did}, cur, int(req.Limit)+1)
 	defer iter.Close()
 
 	var likes []*vyletdatabase.Like
@@ -209,22 +218,17 @@ func (s *Server) GetLikesBySubject(ctx context.Context, req *vyletdatabase.GetLi
 	}
 	if err := iter.Close(); err != nil {
 		logger.Error("failed to iterate likes", "err", err)
-		return &vyletdatabase.GetLikesBySubjectResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	var nextCursor *string
 	if len(likes) > int(req.Limit) {
 		likes = likes[:req.Limit]
 		lastLike := likes[len(likes)-1]
-		cursorStr := fmt.Sprintf("%s|%s",
-			lastLike.CreatedAt.AsTime().Format(time.RFC3339Nano),
-			lastLike.Uri)
-		nextCursor = &cursorStr
+		nextCursor = s.encodeCursor(scope, lastLike.CreatedAt.AsTime(), lastLike.Uri)
 	}
 
-	return &vyletdatabase.GetLikesBySubjectResponse{
+	return &vyletdatabase.GetLikesByActorResponse{
 		Likes:  likes,
 		Cursor: nextCursor,
 	}, nil
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// How posts' like counts are kept
+type LikeCountStrategy string
+
+const (
+	// Like counts are kept in a counter that's incremented and decremented alongside each like. Counter updates
+	// can't be applied idempotently, so replaying a like's creation counts it twice.
+	LikeCountStrategyCounter LikeCountStrategy = "counter"
+	// Like counts are materialized by counting each post's likes, so they stay accurate however often a like is
+	// replayed. Posts are recounted as soon as they're liked while they have few likes, and periodically otherwise.
+	LikeCountStrategyAggregate LikeCountStrategy = "aggregate"
+)
+
+const (
+	// How often posts whose likes changed are recounted
+	likeCountInterval = 10 * time.Second
+	// Job that recounts every liked post once, so that posts liked while the counter was in use are counted
+	likeCountBackfillJob = "like_count_backfill"
+	// Posts with fewer likes than this are recounted as soon as they're liked or unliked. Posts with more are left to
+	// the periodic recount, since counting their likes on every write would be too expensive.
+	likeCountWriteThroughLimit = 1_000
+	// How often posts with at least likeCountWriteThroughLimit likes are recounted while they keep being liked.
+	// Counting scans every one of a post's likes, so popular posts are recounted less often than likeCountInterval.
+	largeLikeCountInterval = 5 * time.Minute
+)
+
+func (s LikeCountStrategy) valid() bool {
+	return s == LikeCountStrategyCounter || s == LikeCountStrategyAggregate
+}
+
+// Queues a post to be recounted, in the same batch as the like that changed it. Only the aggregate strategy recounts
+// posts, so nothing is queued under the counter. Posts liked while the counter was in use are recounted by the
+// backfill instead.
+func (s *Server) addLikeCountUpdate(batch *gocql.Batch, postUri string, now time.Time) {
+	if s.likeCountStrategy != LikeCountStrategyAggregate {
+		return
+	}
+
+	batch.Query(`
+		INSERT INTO post_like_count_updates
+			(post_uri, updated_at)
+		VALUES
+			(?, ?)
+	`, postUri, now)
+}
+
+// Keeps a post's like count up to date after one of its likes is created or deleted. With the counter strategy the
+// counter is incremented by delta, while with the aggregate strategy the post is recounted if it has few likes. A
+// recount that fails is only logged, since the like's batch already queued the post to be recounted.
+func (s *Server) updateLikeCount(ctx context.Context, postUri string, delta int64) error {
+	if s.likeCountStrategy == LikeCountStrategyCounter {
+		if err := s.cqlSession.Query(`
+			UPDATE post_interaction_counts
+			SET like_count = like_count + ?
+			WHERE post_uri = ?
+		`, delta, postUri).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to update like count: %w", err)
+		}
+		return nil
+	}
+
+	logger := s.logger.With("name", "updateLikeCount", "uri", postUri)
+
+	var likeCount int64
+	if err := s.readQuery(`
+		SELECT like_count
+		FROM post_like_counts
+		WHERE post_uri = ?
+	`, postUri).WithContext(ctx).Scan(&likeCount); err != nil && err != gocql.ErrNotFound {
+		logger.Error("failed to fetch like count", "err", err)
+		return nil
+	}
+	if likeCount >= likeCountWriteThroughLimit {
+		return nil
+	}
+
+	if _, err := s.countLikes(ctx, postUri); err != nil {
+		logger.Error("failed to count likes", "err", err)
+	}
+
+	return nil
+}
+
+// Returns posts' like counts by uri. Posts that haven't been counted yet, such as ones only liked before switching
+// from the counter that the backfill hasn't reached, are left out and so have no likes.
+func (s *Server) getLikeCounts(ctx context.Context, postUris []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(postUris))
+	if len(postUris) == 0 {
+		return counts, nil
+	}
+
+	table := "post_like_counts"
+	if s.likeCountStrategy == LikeCountStrategyCounter {
+		table = "post_interaction_counts"
+	}
+
+	iter := s.readQuery(fmt.Sprintf(`
+		SELECT post_uri, like_count
+		FROM %s
+		WHERE post_uri IN ?
+	`, table), postUris).WithContext(ctx).Iter()
+
+	var (
+		uri       string
+		likeCount int64
+	)
+	for iter.Scan(&uri, &likeCount) {
+		counts[uri] = likeCount
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate like counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Counts a post's likes and stores the count, then clears any recount queued before counting started. Writes are
+// timestamped with when counting started, so that a count that finishes late can't overwrite a newer one, and a
+// recount queued while counting isn't cleared.
+func (s *Server) countLikes(ctx context.Context, postUri string) (int64, error) {
+	startedAt := time.Now().UTC()
+
+	var likeCount int64
+	if err := s.readQuery(`
+		SELECT COUNT(*)
+		FROM likes_by_subject
+		WHERE subject_uri = ?
+	`, postUri).WithContext(ctx).Scan(&likeCount); err != nil {
+		return 0, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	if err := s.cqlSession.Query(`
+		INSERT INTO post_like_counts
+			(post_uri, like_count, counted_at)
+		VALUES
+			(?, ?, ?)
+		USING TIMESTAMP ?
+	`, postUri, likeCount, startedAt, startedAt.UnixMicro()).WithContext(ctx).Exec(); err != nil {
+		return 0, fmt.Errorf("failed to store like count: %w", err)
+	}
+
+	if err := s.cqlSession.Query(`
+		DELETE FROM post_like_count_updates
+		USING TIMESTAMP ?
+		WHERE post_uri = ?
+	`, startedAt.UnixMicro(), postUri).WithContext(ctx).Exec(); err != nil {
+		return 0, fmt.Errorf("failed to clear like count update: %w", err)
+	}
+
+	return likeCount, nil
+}
+
+// Periodically keeps aggregate like counts up to date until the context is cancelled. Under the aggregate strategy
+// posts whose likes changed are recounted and the backfill is worked through. Under the counter strategy likes don't
+// queue recounts, so the backfill is reset instead, for it to start over once every replica uses aggregate counts.
+func (s *Server) runLikeCounts(ctx context.Context) {
+	logger := s.logger.With("name", "runLikeCounts")
+
+	ticker := time.NewTicker(likeCountInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.likeCountStrategy != LikeCountStrategyAggregate {
+			if err := s.resetJobProgress(ctx, likeCountBackfillJob); err != nil {
+				logger.Error("failed to reset like count backfill", "err", err)
+			}
+			continue
+		}
+
+		if err := s.processLikeCountUpdates(ctx); err != nil {
+			logger.Error("failed to process like count updates", "err", err)
+		}
+		if err := s.backfillLikeCounts(ctx); err != nil {
+			logger.Error("failed to backfill like counts", "err", err)
+		}
+	}
+}
+
+// Recounts the posts queued in post_like_count_updates. Replicas lease shards of the table, so each post is only
+// recounted by one replica at a time.
+func (s *Server) processLikeCountUpdates(ctx context.Context) error {
+	return s.forEachJobPartition(ctx, "like_counts", "post_like_count_updates", "post_uri", s.processLikeCountUpdate)
+}
+
+// Recounts a queued post. Posts with many likes are recounted at most once every largeLikeCountInterval, and stay
+// queued until then.
+func (s *Server) processLikeCountUpdate(ctx context.Context, postUri string) {
+	logger := s.logger.With("name", "processLikeCountUpdate", "uri", postUri)
+
+	var (
+		likeCount int64
+		countedAt time.Time
+	)
+	if err := s.readQuery(`
+		SELECT like_count, counted_at
+		FROM post_like_counts
+		WHERE post_uri = ?
+	`, postUri).WithContext(ctx).Scan(&likeCount, &countedAt); err != nil && err != gocql.ErrNotFound {
+		logger.Error("failed to fetch like count", "err", err)
+		return
+	}
+	if likeCount >= likeCountWriteThroughLimit && time.Since(countedAt) < largeLikeCountInterval {
+		return
+	}
+
+	s.recountLikes(ctx, postUri)
+}
+
+// Recounts every liked post once, resuming where it was left on each run until every post has been counted
+func (s *Server) backfillLikeCounts(ctx context.Context) error {
+	return s.forEachJobPartitionOnce(ctx, likeCountBackfillJob, "likes_by_subject", "subject_uri", s.recountLikes)
+}
+
+// Recounts a post's likes. A recount that fails is queued to be retried by the like counts job, and one that's
+// already queued stays queued.
+func (s *Server) recountLikes(ctx context.Context, postUri string) {
+	logger := s.logger.With("name", "recountLikes", "uri", postUri)
+
+	if _, err := s.countLikes(ctx, postUri); err != nil {
+		logger.Error("failed to count likes", "err", err)
+
+		if err := s.cqlSession.Query(`
+			INSERT INTO post_like_count_updates
+				(post_uri, updated_at)
+			VALUES
+				(?, ?)
+		`, postUri, time.Now().UTC()).WithContext(ctx).Exec(); err != nil {
+			logger.Error("failed to queue like count update", "err", err)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Notification reasons as they are stored in the reason column
+var notificationReasons = map[vyletdatabase.NotificationReason]string{
+	vyletdatabase.NotificationReason_NOTIFICATION_REASON_LIKE:    "like",
+	vyletdatabase.NotificationReason_NOTIFICATION_REASON_FOLLOW:  "follow",
+	vyletdatabase.NotificationReason_NOTIFICATION_REASON_COMMENT: "comment",
+	vyletdatabase.NotificationReason_NOTIFICATION_REASON_MENTION: "mention",
+}
+
+// Most unread notifications counted. Counting stops here rather than scanning the whole of an actor's unread
+// notifications.
+const maxUnreadNotificationCount = 100
+
+func notificationReasonFromString(reason string) vyletdatabase.NotificationReason {
+	for r, str := range notificationReasons {
+		if str == reason {
+			return r
+		}
+	}
+	return vyletdatabase.NotificationReason_NOTIFICATION_REASON_UNSPECIFIED
+}
+
+func (s *Server) CreateNotifications(ctx context.Context, req *vyletdatabase.CreateNotificationsRequest) (*vyletdatabase.CreateNotificationsResponse, error) {
+	logger := s.logger.With("name", "CreateNotifications")
+
+	if len(req.Notifications) == 0 {
+		return &vyletdatabase.CreateNotificationsResponse{}, nil
+	}
+
+	now := time.Now().UTC()
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, notif := range req.Notifications {
+		reason, ok := notificationReasons[notif.Reason]
+		if !ok {
+			return nil, invalidArgumentError("unknown notification reason %s", notif.Reason)
+		}
+
+		createdAt := notif.CreatedAt.AsTime()
+
+		// Read state is based on when a notification was first indexed rather than the record's own time, which
+		// its author controls. A replayed notification keeps its first indexed time, so it isn't unread again.
+		indexedAt, err := s.getNotificationIndexedAt(ctx, notif.Uri, notif.RecipientDid)
+		if err != nil {
+			logger.Error("failed to get notification indexed at", "uri", notif.Uri, "err", err)
+			return nil, databaseError(err)
+		}
+		if indexedAt == nil {
+			indexedAt = &now
+		}
+
+		batch.Query(`
+			INSERT INTO notifications_by_recipient
+				(recipient_did, created_at, uri, cid, author_did, reason, reason_subject, indexed_at)
+			VALUES
+				(?, ?, ?, ?, ?, ?, ?, ?)
+		`, notif.RecipientDid, createdAt, notif.Uri, notif.Cid, notif.AuthorDid, reason, notif.ReasonSubject, *indexedAt)
+
+		batch.Query(`
+			INSERT INTO notifications_by_recipient_indexed
+				(recipient_did, indexed_at, uri)
+			VALUES
+				(?, ?, ?)
+		`, notif.RecipientDid, *indexedAt, notif.Uri)
+
+		batch.Query(`
+			INSERT INTO notifications_by_uri
+				(uri, recipient_did, created_at, indexed_at)
+			VALUES
+				(?, ?, ?, ?)
+		`, notif.Uri, notif.RecipientDid, createdAt, *indexedAt)
+	}
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to create notifications", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.CreateNotificationsResponse{}, nil
+}
+
+func (s *Server) DeleteNotificationsByUri(ctx context.Context, req *vyletdatabase.DeleteNotificationsByUriRequest) (*vyletdatabase.DeleteNotificationsByUriResponse, error) {
+	logger := s.logger.With("name", "DeleteNotificationsByUri", "uri", req.Uri)
+
+	iter := s.readQuery(`
+		SELECT recipient_did, created_at, indexed_at
+		FROM notifications_by_uri
+		WHERE uri = ?
+	`, req.Uri).WithContext(ctx).Iter()
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	var (
+		recipientDid string
+		createdAt    time.Time
+		indexedAt    *time.Time
+	)
+	for iter.Scan(&recipientDid, &createdAt, &indexedAt) {
+		batch.Query(`
+			DELETE FROM notifications_by_recipient
+			WHERE recipient_did = ? AND created_at = ? AND uri = ?
+		`, recipientDid, createdAt, req.Uri)
+
+		// Notifications created before their indexed time was recorded here were never unread counted
+		if indexedAt != nil {
+			batch.Query(`
+				DELETE FROM notifications_by_recipient_indexed
+				WHERE recipient_did = ? AND indexed_at = ? AND uri = ?
+			`, recipientDid, *indexedAt, req.Uri)
+		}
+		indexedAt = nil
+	}
+
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate notifications", "err", err)
+		return nil, databaseError(err)
+	}
+
+	// Most records don't cause any notifications
+	if batch.Size() == 0 {
+		return &vyletdatabase.DeleteNotificationsByUriResponse{}, nil
+	}
+
+	batch.Query(`
+		DELETE FROM notifications_by_uri
+		WHERE uri = ?
+	`, req.Uri)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to delete notifications", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.DeleteNotificationsByUriResponse{}, nil
+}
+
+// Returns when a notification was first indexed, or nil if it hasn't been
+func (s *Server) getNotificationIndexedAt(ctx context.Context, uri string, recipientDid string) (*time.Time, error) {
+	var indexedAt *time.Time
+	if err := s.readQuery(`
+		SELECT indexed_at
+		FROM notifications_by_uri
+		WHERE uri = ? AND recipient_did = ?
+	`, uri, recipientDid).WithContext(ctx).Scan(&indexedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return indexedAt, nil
+}
+
+// Returns when the actor last saw their notifications, or nil if they never have
+func (s *Server) getNotificationsSeenAt(ctx context.Context, did string) (*time.Time, error) {
+	var seenAt time.Time
+	if err := s.readQuery(`
+		SELECT seen_at
+		FROM notification_seen_by_actor
+		WHERE did = ?
+	`, did).WithContext(ctx).Scan(&seenAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &seenAt, nil
+}
+
+func (s *Server) GetNotifications(ctx context.Context, req *vyletdatabase.GetNotificationsRequest) (*vyletdatabase.GetNotificationsResponse, error) {
+	logger := s.logger.With("name", "GetNotifications", "did", req.Did)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	seenAt, err := s.getNotificationsSeenAt(ctx, req.Did)
+	if err != nil {
+		logger.Error("failed to get notifications seen at", "err", err)
+		return nil, databaseError(err)
+	}
+
+	scope := cursorScope("notifications_by_recipient", req.Did)
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
+
+	iter := s.readPage(ctx, `
+		SELECT recipient_did, created_at, uri, cid, author_did, reason, reason_subject, indexed_at
+		FROM notifications_by_recipient
+	`, "recipient_did = ?", []any{req.Did}, cur, int(req.Limit)+1)
+	defer iter.Close()
+
+	var notifications []*vyletdatabase.Notification
+	for {
+		notif := &vyletdatabase.Notification{}
+		var (
+			createdAt, indexedAt time.Time
+			reason               string
+		)
+
+		if !iter.Scan(
+			&notif.RecipientDid,
+			&createdAt,
+			&notif.Uri,
+			&notif.Cid,
+			&notif.AuthorDid,
+			&reason,
+			&notif.ReasonSubject,
+			&indexedAt,
+		) {
+			break
+		}
+
+		notif.Reason = notificationReasonFromString(reason)
+		notif.CreatedAt = timestamppb.New(createdAt)
+		notif.IndexedAt = timestamppb.New(indexedAt)
+		notif.IsRead = seenAt != nil && !indexedAt.After(*seenAt)
+		notifications = append(notifications, notif)
+	}
+
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate notifications", "err", err)
+		return nil, databaseError(err)
+	}
+
+	var nextCursor *string
+	if len(notifications) > int(req.Limit) {
+		notifications = notifications[:req.Limit]
+		lastNotif := notifications[len(notifications)-1]
+		nextCursor = s.encodeCursor(scope, lastNotif.CreatedAt.AsTime(), lastNotif.Uri)
+	}
+
+	resp := &vyletdatabase.GetNotificationsResponse{
+		Notifications: notifications,
+		Cursor:        nextCursor,
+	}
+	if seenAt != nil {
+		resp.SeenAt = timestamppb.New(*seenAt)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetUnreadNotificationCount(ctx context.Context, req *vyletdatabase.GetUnreadNotificationCountRequest) (*vyletdatabase.GetUnreadNotificationCountResponse, error) {
+	logger := s.logger.With("name", "GetUnreadNotificationCount", "did", req.Did)
+
+	seenAt, err := s.getNotificationsSeenAt(ctx, req.Did)
+	if err != nil {
+		logger.Error("failed to get notifications seen at", "err", err)
+		return nil, databaseError(err)
+	}
+
+	// Notifications are counted by when they were indexed, and at most maxUnreadNotificationCount of them are read.
+	// Two writes of the same notification that race may each record their own indexed time, so uris are counted once.
+	query := `
+		SELECT uri
+		FROM notifications_by_recipient_indexed
+		WHERE recipient_did = ?
+	`
+	args := []any{req.Did}
+	if seenAt != nil {
+		query += " AND indexed_at > ?"
+		args = append(args, *seenAt)
+	}
+	query += " LIMIT ?"
+	args = append(args, maxUnreadNotificationCount)
+
+	iter := s.readQuery(query, args...).WithContext(ctx).Iter()
+
+	unread := make(map[string]struct{})
+	var uri string
+	for iter.Scan(&uri) {
+		unread[uri] = struct{}{}
+	}
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to count unread notifications", "err", err)
+		return nil, databaseError(err)
+	}
+	count := int64(len(unread))
+
+	return &vyletdatabase.GetUnreadNotificationCountResponse{
+		Count: count,
+	}, nil
+}
+
+func (s *Server) UpdateNotificationsSeen(ctx context.Context, req *vyletdatabase.UpdateNotificationsSeenRequest) (*vyletdatabase.UpdateNotificationsSeenResponse, error) {
+	logger := s.logger.With("name", "UpdateNotificationsSeen", "did", req.Did)
+
+	if err := s.cqlSession.Query(`
+		INSERT INTO notification_seen_by_actor
+			(did, seen_at)
+		VALUES
+			(?, ?)
+	`, req.Did, req.SeenAt.AsTime()).WithContext(ctx).Exec(); err != nil {
+		logger.Error("failed to update notifications seen at", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.UpdateNotificationsSeenResponse{}, nil
+}
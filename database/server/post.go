@@ -2,15 +2,12 @@ package server
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/gocql/gocql"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
-	"github.com/vylet-app/go/internal/helpers"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,7 +19,7 @@ func (s *Server) getPostImages(ctx context.Context, postUri string) ([]*vyletdat
 		ORDER BY image_index ASC
 	`
 
-	iter := s.cqlSession.Query(query, postUri).WithContext(ctx).Iter()
+	iter := s.readQuery(query, postUri).WithContext(ctx).Iter()
 	defer iter.Close()
 
 	var images []*vyletdatabase.Image
@@ -58,7 +55,7 @@ func (s *Server) CreatePost(ctx context.Context, req *vyletdatabase.CreatePostRe
 
 	aturi, err := syntax.ParseATURI(req.Post.Uri)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse aturi: %w", err)
+		return nil, invalidArgumentError("invalid uri: %v", err)
 	}
 
 	did := aturi.Authority().String()
@@ -71,21 +68,38 @@ func (s *Server) CreatePost(ctx context.Context, req *vyletdatabase.CreatePostRe
 		req.Post.Cid,
 		did,
 		req.Post.Caption,
-		req.Post.Facets,
+		facetsToCql(req.Post.Facets),
 		req.Post.CreatedAt.AsTime(),
 		now,
 	}
 
+	// The legacy JSON facets are cleared, so that a post written before facets were typed doesn't keep serving them
+	// once it's rewritten without any
 	postQuery := `
 		INSERT INTO %s
-			(uri, cid, author_did, caption, facets, created_at, indexed_at)
+			(uri, cid, author_did, caption, facets, typed_facets, created_at, indexed_at)
 		VALUES
-			(?, ?, ?, ?, ?, ?, ?)
+			(?, ?, ?, ?, null, ?, ?, ?)
 	`
 
 	batch.Query(fmt.Sprintf(postQuery, "posts_by_uri"), postArgs...)
 	batch.Query(fmt.Sprintf(postQuery, "posts_by_actor"), postArgs...)
 
+	batch.Query(`
+		UPDATE posts_by_uri
+		SET tags = ?
+		WHERE uri = ?
+	`, req.Post.Tags, req.Post.Uri)
+
+	for _, tag := range req.Post.Tags {
+		batch.Query(`
+			INSERT INTO posts_by_tag
+				(tag, created_at, uri, author_did)
+			VALUES
+				(?, ?, ?, ?)
+		`, tag, req.Post.CreatedAt.AsTime(), req.Post.Uri, did)
+	}
+
 	for idx, img := range req.Post.Images {
 		batch.Query(
 			`INSERT INTO images_by_post
@@ -105,9 +119,12 @@ func (s *Server) CreatePost(ctx context.Context, req *vyletdatabase.CreatePostRe
 
 	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
 		logger.Error("failed to create post", "uri", req.Post.Uri, "err", err)
-		return &vyletdatabase.CreatePostResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
+	}
+
+	// Trending counts are best effort, so a failure here doesn't fail the post
+	if err := s.countPostTags(ctx, req.Post.Uri, req.Post.Tags, now); err != nil {
+		logger.Error("failed to count post tags", "uri", req.Post.Uri, "err", err)
 	}
 
 	return &vyletdatabase.CreatePostResponse{}, nil
@@ -118,27 +135,26 @@ func (s *Server) DeletePost(ctx context.Context, req *vyletdatabase.DeletePostRe
 
 	aturi, err := syntax.ParseATURI(req.Uri)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse aturi: %w", err)
+		return nil, invalidArgumentError("invalid uri: %v", err)
 	}
 	did := aturi.Authority().String()
 
-	var createdAt time.Time
+	var (
+		createdAt time.Time
+		tags      []string
+	)
 	query := `
-		SELECT created_at
+		SELECT created_at, tags
 		FROM posts_by_uri
 		WHERE uri = ?
 	`
-	if err := s.cqlSession.Query(query, req.Uri).WithContext(ctx).Scan(&createdAt); err != nil {
+	if err := s.readQuery(query, req.Uri).WithContext(ctx).Scan(&createdAt, &tags); err != nil {
 		if err == gocql.ErrNotFound {
 			logger.Warn("post not found", "uri", req.Uri)
-			return &vyletdatabase.DeletePostResponse{
-				Error: helpers.ToStringPtr("post not found"),
-			}, nil
+			return nil, notFoundError("post not found")
 		}
 		logger.Error("failed to fetch post", "uri", req.Uri, "err", err)
-		return &vyletdatabase.DeletePostResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
@@ -158,11 +174,54 @@ func (s *Server) DeletePost(ctx context.Context, req *vyletdatabase.DeletePostRe
 		WHERE post_uri = ?
 	`, req.Uri)
 
+	for _, tag := range tags {
+		batch.Query(`
+			DELETE FROM posts_by_tag
+			WHERE tag = ? AND created_at = ? AND uri = ?
+		`, tag, createdAt, req.Uri)
+	}
+
+	// A post created later with the same uri starts without likes
+	batch.Query(`
+		DELETE FROM post_like_counts
+		WHERE post_uri = ?
+	`, req.Uri)
+
+	batch.Query(`
+		DELETE FROM post_like_count_updates
+		WHERE post_uri = ?
+	`, req.Uri)
+
 	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
 		logger.Error("failed to delete post", "uri", req.Uri, "err", err)
-		return &vyletdatabase.DeletePostResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
+	}
+
+	// Counters can't be batched with other tables
+	if err := s.cqlSession.Query(`
+		DELETE FROM post_interaction_counts
+		WHERE post_uri = ?
+	`, req.Uri).WithContext(ctx).Exec(); err != nil {
+		logger.Error("failed to delete post interaction counts", "uri", req.Uri, "err", err)
+		return nil, databaseError(err)
+	}
+
+	if err := s.uncountPostTags(ctx, req.Uri); err != nil {
+		logger.Error("failed to uncount post tags", "uri", req.Uri, "err", err)
+	}
+
+	// Most posts have few enough likes to remove them all now. The rest, or any that failed to be removed, are left
+	// to a post deletion job.
+	moreLikes, err := s.deletePostLikes(ctx, req.Uri, postDeletionInlineLikes)
+	if err != nil {
+		logger.Warn("failed to delete post likes, leaving them to a post deletion job", "uri", req.Uri, "err", err)
+		moreLikes = true
+	}
+	if moreLikes {
+		if err := s.enqueuePostDeletionJob(ctx, req.Uri, did); err != nil {
+			logger.Error("failed to enqueue post deletion job", "uri", req.Uri, "err", err)
+			return nil, databaseError(err)
+		}
 	}
 
 	return &vyletdatabase.DeletePostResponse{}, nil
@@ -172,29 +231,45 @@ func (s *Server) GetPosts(ctx context.Context, req *vyletdatabase.GetPostsReques
 	logger := s.logger.With("name", "GetPosts", "uris", req.Uris)
 
 	if len(req.Uris) == 0 {
-		return nil, fmt.Errorf("at least one URI must be specified")
+		return nil, invalidArgumentError("at least one URI must be specified")
+	}
+
+	posts, err := s.getPostsByUris(ctx, req.Uris)
+	if err != nil {
+		logger.Error("failed to get posts", "err", err)
+		return nil, databaseError(err)
 	}
 
+	return &vyletdatabase.GetPostsResponse{
+		Posts: posts,
+	}, nil
+}
+
+func (s *Server) getPostsByUris(ctx context.Context, uris []string) (map[string]*vyletdatabase.Post, error) {
+	logger := s.logger.With("name", "getPostsByUris")
+
 	query := `
-		SELECT uri, cid, author_did, caption, facets, created_at, indexed_at
+		SELECT uri, cid, author_did, caption, facets, typed_facets, created_at, indexed_at
 		FROM posts_by_uri
 		WHERE uri IN ?
 	`
 
-	iter := s.cqlSession.Query(query, req.Uris).WithContext(ctx).Iter()
+	iter := s.readQuery(query, uris).WithContext(ctx).Iter()
 	defer iter.Close()
 
 	posts := make(map[string]*vyletdatabase.Post)
 	for {
 		post := &vyletdatabase.Post{}
 		var createdAt, indexedAt time.Time
+		var typedFacets []cqlFacet
 
 		if !iter.Scan(
 			&post.Uri,
 			&post.Cid,
 			&post.AuthorDid,
 			&post.Caption,
-			&post.Facets,
+			&post.LegacyFacets,
+			&typedFacets,
 			&createdAt,
 			&indexedAt,
 		) {
@@ -203,6 +278,7 @@ func (s *Server) GetPosts(ctx context.Context, req *vyletdatabase.GetPostsReques
 
 		post.CreatedAt = timestamppb.New(createdAt)
 		post.IndexedAt = timestamppb.New(indexedAt)
+		setPostFacets(post, typedFacets)
 
 		images, err := s.getPostImages(ctx, post.Uri)
 		if err != nil {
@@ -215,80 +291,45 @@ func (s *Server) GetPosts(ctx context.Context, req *vyletdatabase.GetPostsReques
 	}
 
 	if err := iter.Close(); err != nil {
-		logger.Error("failed to iterate posts", "err", err)
-		return &vyletdatabase.GetPostsResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, fmt.Errorf("failed to iterate posts: %w", err)
 	}
 
-	return &vyletdatabase.GetPostsResponse{
-		Posts: posts,
-	}, nil
+	return posts, nil
 }
 
 func (s *Server) GetPostsByActor(ctx context.Context, req *vyletdatabase.GetPostsByActorRequest) (*vyletdatabase.GetPostsByActorResponse, error) {
 	logger := s.logger.With("name", "GetPostsByActor", "did", req.Did)
 
 	if req.Limit <= 0 {
-		return nil, fmt.Errorf("limit must be greater than 0")
+		return nil, invalidArgumentError("limit must be greater than 0")
 	}
 
-	var (
-		query string
-		args  []any
-	)
-
-	if req.Cursor != nil && *req.Cursor != "" {
-		cursorParts := strings.SplitN(*req.Cursor, "|", 2)
-		if len(cursorParts) != 2 {
-			logger.Error("invalid cursor format", "cursor", *req.Cursor)
-			return &vyletdatabase.GetPostsByActorResponse{
-				Error: helpers.ToStringPtr("invalid cursor format"),
-			}, nil
-		}
-
-		cursorTime, err := time.Parse(time.RFC3339Nano, cursorParts[0])
-		if err != nil {
-			logger.Error("failed to parse cursor timestamp", "cursor", *req.Cursor, "err", err)
-			return &vyletdatabase.GetPostsByActorResponse{
-				Error: helpers.ToStringPtr("invalid cursor format"),
-			}, nil
-		}
-		cursorUri := cursorParts[1]
-
-		query = `
-			SELECT uri, cid, author_did, caption, facets, created_at, indexed_at
-			FROM posts_by_actor
-			WHERE author_did = ? AND (created_at, uri) < (?, ?)
-			ORDER BY created_at DESC, uri ASC
-			LIMIT ?
-		`
-		args = []any{req.Did, cursorTime, cursorUri, req.Limit + 1}
-	} else {
-		query = `
-			SELECT uri, cid, author_did, caption, facets, created_at, indexed_at
-			FROM posts_by_actor
-			WHERE author_did = ?
-			ORDER BY created_at DESC, uri ASC
-			LIMIT ?
-		`
-		args = []any{req.Did, req.Limit + 1}
+	scope := cursorScope("posts_by_actor", req.Did)
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
 	}
 
-	iter := s.cqlSession.Query(query, args...).WithContext(ctx).Iter()
+	iter := s.readPage(ctx, `
+		SELECT uri, cid, author_did, caption, facets, typed_facets, created_at, indexed_at
+		FROM posts_by_actor
+	`, "author_did = ?", []any{req.Did}, cur, int(req.Limit)+1)
 	defer iter.Close()
 
 	var postsList []*vyletdatabase.Post
 	for {
 		post := &vyletdatabase.Post{}
 		var createdAt, indexedAt time.Time
+		var typedFacets []cqlFacet
 
 		if !iter.Scan(
 			&post.Uri,
 			&post.Cid,
 			&post.AuthorDid,
 			&post.Caption,
-			&post.Facets,
+			&post.LegacyFacets,
+			&typedFacets,
 			&createdAt,
 			&indexedAt,
 		) {
@@ -297,24 +338,20 @@ func (s *Server) GetPostsByActor(ctx context.Context, req *vyletdatabase.GetPost
 
 		post.CreatedAt = timestamppb.New(createdAt)
 		post.IndexedAt = timestamppb.New(indexedAt)
+		setPostFacets(post, typedFacets)
 		postsList = append(postsList, post)
 	}
 
 	if err := iter.Close(); err != nil {
 		logger.Error("failed to iterate posts", "err", err)
-		return &vyletdatabase.GetPostsByActorResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	var nextCursor *string
 	if len(postsList) > int(req.Limit) {
 		postsList = postsList[:req.Limit]
 		lastPost := postsList[len(postsList)-1]
-		cursorStr := fmt.Sprintf("%s|%s",
-			lastPost.CreatedAt.AsTime().Format(time.RFC3339Nano),
-			lastPost.Uri)
-		nextCursor = &cursorStr
+		nextCursor = s.encodeCursor(scope, lastPost.CreatedAt.AsTime(), lastPost.Uri)
 	}
 
 	posts := make(map[string]*vyletdatabase.Post)
@@ -337,34 +374,16 @@ func (s *Server) GetPostsByActor(ctx context.Context, req *vyletdatabase.GetPost
 func (s *Server) GetPostInteractionCounts(ctx context.Context, req *vyletdatabase.GetPostInteractionCountsRequest) (*vyletdatabase.GetPostInteractionCountsResponse, error) {
 	logger := s.logger.With("name", "GetPostInteractionCounts", "uri", req.Uri)
 
-	var likeCount, replyCount int64
-
-	query := `
-		SELECT like_count
-		FROM post_interaction_counts
-		WHERE post_uri = ?
-	`
-
-	err := s.cqlSession.Query(query, req.Uri).WithContext(ctx).Scan(&likeCount)
+	likeCounts, err := s.getLikeCounts(ctx, []string{req.Uri})
 	if err != nil {
-		if errors.Is(err, gocql.ErrNotFound) {
-			return &vyletdatabase.GetPostInteractionCountsResponse{
-				Counts: &vyletdatabase.PostInteractionCounts{
-					Likes:   0,
-					Replies: 0,
-				},
-			}, nil
-		}
 		logger.Error("failed to fetch interaction counts", "uri", req.Uri, "err", err)
-		return &vyletdatabase.GetPostInteractionCountsResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.GetPostInteractionCountsResponse{
 		Counts: &vyletdatabase.PostInteractionCounts{
-			Likes:   likeCount,
-			Replies: replyCount,
+			Likes:   likeCounts[req.Uri],
+			Replies: 0,
 		},
 	}, nil
 }
@@ -372,39 +391,17 @@ func (s *Server) GetPostInteractionCounts(ctx context.Context, req *vyletdatabas
 func (s *Server) GetPostsInteractionCounts(ctx context.Context, req *vyletdatabase.GetPostsInteractionCountsRequest) (*vyletdatabase.GetPostsInteractionCountsResponse, error) {
 	logger := s.logger.With("name", "GetPostsInteractionCounts")
 
-	query := `
-		SELECT post_uri, like_count
-		FROM post_interaction_counts
-		WHERE post_uri IN ?
-	`
-
-	iter := s.cqlSession.Query(query, req.Uris).WithContext(ctx).Iter()
-	defer iter.Close()
-
-	counts := make(map[string]*vyletdatabase.PostInteractionCounts)
-
-	var uri string
-	var likeCount, replyCount int64
-	for iter.Scan(&uri, &likeCount) {
-		counts[uri] = &vyletdatabase.PostInteractionCounts{
-			Likes:   likeCount,
-			Replies: replyCount,
-		}
-	}
-
-	if err := iter.Close(); err != nil {
-		logger.Error("failed to iterate interaction counts", "err", err)
-		return &vyletdatabase.GetPostsInteractionCountsResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+	likeCounts, err := s.getLikeCounts(ctx, req.Uris)
+	if err != nil {
+		logger.Error("failed to fetch interaction counts", "err", err)
+		return nil, databaseError(err)
 	}
 
+	counts := make(map[string]*vyletdatabase.PostInteractionCounts, len(req.Uris))
 	for _, uri := range req.Uris {
-		if _, exists := counts[uri]; !exists {
-			counts[uri] = &vyletdatabase.PostInteractionCounts{
-				Likes:   0,
-				Replies: 0,
-			}
+		counts[uri] = &vyletdatabase.PostInteractionCounts{
+			Likes:   likeCounts[uri],
+			Replies: 0,
 		}
 	}
 
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	// Number of likes removed while deleting a post. Posts with more likes than this have the rest removed by a
+	// post deletion job.
+	postDeletionInlineLikes = 100
+	// Number of likes removed in each batch by a post deletion job
+	postDeletionJobPageSize = 100
+	// How often pending post deletion jobs are checked for
+	postDeletionJobInterval = 30 * time.Second
+)
+
+// Removes up to limit of a deleted post's likes, from every like table so that the post disappears from its likers'
+// lists. Returns whether there may be more likes to remove.
+func (s *Server) deletePostLikes(ctx context.Context, uri string, limit int) (bool, error) {
+	iter := s.readQuery(`
+		SELECT uri, author_did, created_at
+		FROM likes_by_subject
+		WHERE subject_uri = ?
+		LIMIT ?
+	`, uri, limit).WithContext(ctx).Iter()
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	var (
+		likeUri   string
+		authorDid string
+		createdAt time.Time
+		count     int
+	)
+	for iter.Scan(&likeUri, &authorDid, &createdAt) {
+		count++
+
+		batch.Query(`
+			DELETE FROM likes_by_uri
+			WHERE uri = ?
+		`, likeUri)
+
+		batch.Query(`
+			DELETE FROM likes_by_actor
+			WHERE author_did = ? AND created_at = ? AND uri = ?
+		`, authorDid, createdAt, likeUri)
+
+		batch.Query(`
+			DELETE FROM likes_by_subject
+			WHERE subject_uri = ? AND created_at = ? AND uri = ?
+		`, uri, createdAt, likeUri)
+	}
+	if err := iter.Close(); err != nil {
+		return false, fmt.Errorf("failed to iterate likes: %w", err)
+	}
+
+	if count == 0 {
+		return false, nil
+	}
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		return false, fmt.Errorf("failed to delete likes: %w", err)
+	}
+
+	return count == limit, nil
+}
+
+// Records that a deleted post still has likes to remove, for a post deletion job to pick up
+func (s *Server) enqueuePostDeletionJob(ctx context.Context, uri string, authorDid string) error {
+	if err := s.cqlSession.Query(`
+		INSERT INTO post_deletion_jobs
+			(uri, author_did, enqueued_at)
+		VALUES
+			(?, ?, ?)
+	`, uri, authorDid, time.Now().UTC()).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to enqueue post deletion job: %w", err)
+	}
+
+	return nil
+}
+
+// Periodically runs pending post deletion jobs until the context is cancelled. Replicas lease shards of the job
+// table, so each job is only run by one replica at a time.
+func (s *Server) runPostDeletionJobs(ctx context.Context) {
+	logger := s.logger.With("name", "runPostDeletionJobs")
+
+	ticker := time.NewTicker(postDeletionJobInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.processPostDeletionJobs(ctx); err != nil {
+			logger.Error("failed to process post deletion jobs", "err", err)
+		}
+	}
+}
+
+func (s *Server) processPostDeletionJobs(ctx context.Context) error {
+	return s.forEachJobPartition(ctx, "post_deletion", "post_deletion_jobs", "uri", s.runPostDeletionJob)
+}
+
+// Removes the rest of a deleted post's likes, then any like count taken from them and the job itself. A job that
+// fails is left in place to be retried on the next run.
+func (s *Server) runPostDeletionJob(ctx context.Context, uri string) {
+	logger := s.logger.With("name", "runPostDeletionJob", "uri", uri)
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		more, err := s.deletePostLikes(ctx, uri, postDeletionJobPageSize)
+		if err != nil {
+			logger.Error("failed to delete post likes", "err", err)
+			return
+		}
+		if !more {
+			break
+		}
+	}
+
+	// Like counts taken while the likes were being removed are removed along with the job
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	batch.Query(`
+		DELETE FROM post_like_counts
+		WHERE post_uri = ?
+	`, uri)
+
+	batch.Query(`
+		DELETE FROM post_like_count_updates
+		WHERE post_uri = ?
+	`, uri)
+
+	batch.Query(`
+		DELETE FROM post_deletion_jobs
+		WHERE uri = ?
+	`, uri)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to delete finished post deletion job", "err", err)
+		return
+	}
+
+	logger.Info("finished post deletion job")
+}
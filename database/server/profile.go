@@ -2,10 +2,12 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/gocql/gocql"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
-	"github.com/vylet-app/go/internal/helpers"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,45 +32,47 @@ func (s *Server) CreateProfile(ctx context.Context, req *vyletdatabase.CreatePro
 		now,
 	).WithContext(ctx).Exec(); err != nil {
 		logger.Error("failed to create profile", "did", req.Profile.Did, "err", err)
-		return &vyletdatabase.CreateProfileResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.CreateProfileResponse{}, nil
 }
 
-func (s *Server) UpdateProfile(ctx context.Context, req *vyletdatabase.CreateProfileRequest) (*vyletdatabase.CreateProfileResponse, error) {
+func (s *Server) UpdateProfile(ctx context.Context, req *vyletdatabase.UpdateProfileRequest) (*vyletdatabase.UpdateProfileResponse, error) {
 	logger := s.logger.With("name", "UpdateProfile")
 
 	now := time.Now().UTC()
 
-	if err := s.cqlSession.Query(
-		`
+	assignments, values, err := maskedAssignments(req.UpdateMask, map[string]any{
+		"display_name": req.Profile.DisplayName,
+		"description":  req.Profile.Description,
+		"pronouns":     req.Profile.Pronouns,
+		"avatar":       req.Profile.Avatar,
+	})
+	if err != nil {
+		return nil, err
+	}
+	assignments = append(assignments, "updated_at = ?")
+	values = append(values, now, req.Profile.Did)
+
+	// Conditional on the profile existing, since an update would otherwise create it without a created_at
+	query := fmt.Sprintf(`
 		UPDATE profiles
-		SET
-			display_name = ?,
-			description = ?,
-			pronouns = ?,
-			avatar = ?,
-			updated_at = ?
-		WHERE
-			did = ?
-		`,
-		req.Profile.DisplayName,
-		req.Profile.Description,
-		req.Profile.Pronouns,
-		req.Profile.Avatar,
-		now,
-		req.Profile.Did,
-	).WithContext(ctx).Exec(); err != nil {
-		logger.Error("failed to create profile", "did", req.Profile.Did, "err", err)
-		return &vyletdatabase.CreateProfileResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		SET %s
+		WHERE did = ?
+		IF EXISTS
+	`, strings.Join(assignments, ", "))
+
+	applied, err := s.cqlSession.Query(query, values...).WithContext(ctx).MapScanCAS(make(map[string]any))
+	if err != nil {
+		logger.Error("failed to update profile", "did", req.Profile.Did, "err", err)
+		return nil, databaseError(err)
+	}
+	if !applied {
+		return nil, notFoundError("profile not found")
 	}
 
-	return &vyletdatabase.CreateProfileResponse{}, nil
+	return &vyletdatabase.UpdateProfileResponse{}, nil
 }
 
 func (s *Server) DeleteProfile(ctx context.Context, req *vyletdatabase.DeleteProfileRequest) (*vyletdatabase.DeleteProfileResponse, error) {
@@ -83,14 +87,68 @@ func (s *Server) DeleteProfile(ctx context.Context, req *vyletdatabase.DeletePro
 		req.Did,
 	).WithContext(ctx).Exec(); err != nil {
 		logger.Error("failed to delete profile", "did", req.Did, "err", err)
-		return &vyletdatabase.DeleteProfileResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return &vyletdatabase.DeleteProfileResponse{}, nil
 }
 
+// Active actors have no row in inactive_actors. Writes are timestamped with the account event's time, so a replayed
+// older event can't undo a newer one.
+func (s *Server) SetActorStatus(ctx context.Context, req *vyletdatabase.SetActorStatusRequest) (*vyletdatabase.SetActorStatusResponse, error) {
+	logger := s.logger.With("name", "SetActorStatus", "did", req.Did)
+
+	eventTime := req.Time.AsTime()
+
+	var query *gocql.Query
+	if req.Active {
+		query = s.cqlSession.Query(`
+			DELETE FROM inactive_actors
+			USING TIMESTAMP ?
+			WHERE did = ?
+		`, eventTime.UnixMicro(), req.Did)
+	} else {
+		query = s.cqlSession.Query(`
+			INSERT INTO inactive_actors
+				(did, status, updated_at)
+			VALUES
+				(?, ?, ?)
+			USING TIMESTAMP ?
+		`, req.Did, req.Status, eventTime, eventTime.UnixMicro())
+	}
+
+	if err := query.WithContext(ctx).Exec(); err != nil {
+		logger.Error("failed to set actor status", "active", req.Active, "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.SetActorStatusResponse{}, nil
+}
+
+// Returns which of the given actors are inactive
+func (s *Server) getInactiveActors(ctx context.Context, dids []string) (map[string]struct{}, error) {
+	inactive := make(map[string]struct{})
+	if len(dids) == 0 {
+		return inactive, nil
+	}
+
+	iter := s.readQuery(`
+		SELECT did
+		FROM inactive_actors
+		WHERE did IN ?
+	`, dids).WithContext(ctx).Iter()
+
+	var did string
+	for iter.Scan(&did) {
+		inactive[did] = struct{}{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate inactive actors: %w", err)
+	}
+
+	return inactive, nil
+}
+
 func (s *Server) GetProfile(ctx context.Context, req *vyletdatabase.GetProfileRequest) (*vyletdatabase.GetProfileResponse, error) {
 	logger := s.logger.With("name", "GetProfile")
 
@@ -99,7 +157,7 @@ func (s *Server) GetProfile(ctx context.Context, req *vyletdatabase.GetProfileRe
 	}
 	var createdAt, indexedAt time.Time
 
-	if err := s.cqlSession.Query(
+	if err := s.readQuery(
 		`SELECT
 			did,
 			display_name,
@@ -122,10 +180,12 @@ func (s *Server) GetProfile(ctx context.Context, req *vyletdatabase.GetProfileRe
 		&createdAt,
 		&indexedAt,
 	); err != nil {
+		// Actors without a profile are looked up routinely, so they aren't logged
+		if err == gocql.ErrNotFound {
+			return nil, notFoundError("profile not found")
+		}
 		logger.Error("failed to get profile", "did", req.Did, "err", err)
-		return &vyletdatabase.GetProfileResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	resp.Profile.CreatedAt = timestamppb.New(createdAt)
@@ -141,7 +201,7 @@ func (s *Server) GetProfiles(ctx context.Context, req *vyletdatabase.GetProfiles
 		Profiles: make(map[string]*vyletdatabase.Profile),
 	}
 
-	iter := s.cqlSession.Query(
+	iter := s.readQuery(
 		`SELECT
 			did,
 			display_name,
@@ -181,9 +241,7 @@ func (s *Server) GetProfiles(ctx context.Context, req *vyletdatabase.GetProfiles
 
 	if err := iter.Close(); err != nil {
 		logger.Error("failed to get profiles", "dids", req.Dids, "err", err)
-		return &vyletdatabase.GetProfilesResponse{
-			Error: helpers.ToStringPtr(err.Error()),
-		}, nil
+		return nil, databaseError(err)
 	}
 
 	return resp, nil
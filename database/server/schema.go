@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vylet-app/go/database/migrate"
+)
+
+// How often the schema version is checked, and how often a replica retries migrating while another holds the lock
+const schemaCheckInterval = 10 * time.Second
+
+// Periodically checks that the schema has every migration the server was built with until the context is cancelled,
+// applying pending ones first when auto migration is enabled. Checks continue once the schema is ready, so that the
+// server stops being ready if a migration is rolled back.
+func (s *Server) runSchemaChecks(ctx context.Context) {
+	logger := s.logger.With("name", "runSchemaChecks")
+
+	ticker := time.NewTicker(schemaCheckInterval)
+	defer ticker.Stop()
+
+	first := true
+	for {
+		ready := s.checkSchema(ctx)
+		if wasReady := s.health.schemaReady.Swap(ready); wasReady != ready || first {
+			switch {
+			case ready:
+				logger.Info("schema is up to date")
+			case s.autoMigrate:
+				logger.Warn("schema is not up to date, waiting for migrations")
+			default:
+				logger.Warn("schema is not up to date, run the migrate tool or enable auto migration")
+			}
+		}
+		first = false
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) checkSchema(ctx context.Context) bool {
+	logger := s.logger.With("name", "checkSchema")
+
+	upToDate, err := s.migrator.UpToDate(ctx)
+	if err != nil {
+		logger.Warn("failed to check schema version", "err", err)
+		return false
+	}
+	if upToDate || !s.autoMigrate {
+		return upToDate
+	}
+
+	if err := s.migrator.Up(ctx); err != nil {
+		if errors.Is(err, migrate.ErrLocked) {
+			logger.Info("another replica is migrating", "err", err)
+		} else if ctx.Err() == nil {
+			logger.Error("failed to migrate", "err", err)
+		}
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,761 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/gocql/gocql"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/internal/cursor"
+	"github.com/vylet-app/go/internal/search"
+)
+
+const (
+	// Number of term rows read at a time when scanning for search results
+	searchPageSize = 100
+	// Maximum number of term rows scanned for a single search request. Searches for several common terms may need
+	// to scan a lot of rows to find matches, so they return a cursor to continue from instead of scanning forever.
+	maxSearchScan = 1000
+	// Number of actors kept under each typeahead prefix. The lowest ranked actors are trimmed from a prefix once it
+	// grows past this, so that prefixes that many actors share don't become huge partitions.
+	maxTypeaheadPrefixSize = 1000
+)
+
+// Terms of a document that should be removed when it is reindexed with new terms. Rows are only removed when they
+// won't be rewritten, since a delete and insert of the same row in one batch resolve in favor of the delete.
+func staleTerms(oldTerms, newTerms []string, keyChanged bool) []string {
+	if keyChanged {
+		return oldTerms
+	}
+
+	var stale []string
+	for _, term := range oldTerms {
+		if !slices.Contains(newTerms, term) {
+			stale = append(stale, term)
+		}
+	}
+	return stale
+}
+
+func containsAllTerms(docTerms, terms []string) bool {
+	for _, term := range terms {
+		if !slices.Contains(docTerms, term) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) IndexPost(ctx context.Context, req *vyletdatabase.IndexPostRequest) (*vyletdatabase.IndexPostResponse, error) {
+	logger := s.logger.With("name", "IndexPost", "uri", req.Uri)
+
+	aturi, err := syntax.ParseATURI(req.Uri)
+	if err != nil {
+		return nil, invalidArgumentError("invalid uri: %v", err)
+	}
+	did := aturi.Authority().String()
+
+	var caption string
+	if req.Caption != nil {
+		caption = *req.Caption
+	}
+	terms := search.PostTerms(caption, req.AltTexts, req.Tags)
+	createdAt := req.CreatedAt.AsTime()
+
+	var (
+		oldCreatedAt time.Time
+		oldTerms     []string
+	)
+	if err := s.readQuery(`
+		SELECT created_at, terms
+		FROM post_search_documents
+		WHERE uri = ?
+	`, req.Uri).WithContext(ctx).Scan(&oldCreatedAt, &oldTerms); err != nil && err != gocql.ErrNotFound {
+		logger.Error("failed to get existing search document", "err", err)
+		return nil, databaseError(err)
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, term := range staleTerms(oldTerms, terms, !oldCreatedAt.Equal(createdAt)) {
+		batch.Query(`
+			DELETE FROM post_search_terms
+			WHERE term = ? AND created_at = ? AND uri = ?
+		`, term, oldCreatedAt, req.Uri)
+	}
+
+	for _, term := range terms {
+		batch.Query(`
+			INSERT INTO post_search_terms
+				(term, created_at, uri)
+			VALUES
+				(?, ?, ?)
+		`, term, createdAt, req.Uri)
+	}
+
+	batch.Query(`
+		INSERT INTO post_search_documents
+			(uri, author_did, created_at, terms, image_cids)
+		VALUES
+			(?, ?, ?, ?, ?)
+	`, req.Uri, did, createdAt, terms, req.ImageCids)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to index post", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.IndexPostResponse{}, nil
+}
+
+func (s *Server) DeletePostFromIndex(ctx context.Context, req *vyletdatabase.DeletePostFromIndexRequest) (*vyletdatabase.DeletePostFromIndexResponse, error) {
+	logger := s.logger.With("name", "DeletePostFromIndex", "uri", req.Uri)
+
+	var (
+		createdAt time.Time
+		terms     []string
+	)
+	if err := s.readQuery(`
+		SELECT created_at, terms
+		FROM post_search_documents
+		WHERE uri = ?
+	`, req.Uri).WithContext(ctx).Scan(&createdAt, &terms); err != nil {
+		if err == gocql.ErrNotFound {
+			return &vyletdatabase.DeletePostFromIndexResponse{}, nil
+		}
+		logger.Error("failed to get search document", "err", err)
+		return nil, databaseError(err)
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, term := range terms {
+		batch.Query(`
+			DELETE FROM post_search_terms
+			WHERE term = ? AND created_at = ? AND uri = ?
+		`, term, createdAt, req.Uri)
+	}
+
+	batch.Query(`
+		DELETE FROM post_search_documents
+		WHERE uri = ?
+	`, req.Uri)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to delete post from index", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.DeletePostFromIndexResponse{}, nil
+}
+
+func (s *Server) IndexActor(ctx context.Context, req *vyletdatabase.IndexActorRequest) (*vyletdatabase.IndexActorResponse, error) {
+	logger := s.logger.With("name", "IndexActor", "did", req.Did)
+
+	var handle, displayName, description string
+	if req.Handle != nil {
+		handle = *req.Handle
+	}
+	if req.DisplayName != nil {
+		displayName = *req.DisplayName
+	}
+	if req.Description != nil {
+		description = *req.Description
+	}
+	terms := search.ActorTerms(handle, displayName, description)
+	prefixes := search.TypeaheadPrefixes(handle, displayName)
+
+	var (
+		oldTerms    []string
+		oldPrefixes []string
+		oldRankedAt time.Time
+	)
+	if err := s.readQuery(`
+		SELECT terms, typeahead_prefixes, ranked_at
+		FROM actor_search_documents
+		WHERE did = ?
+	`, req.Did).WithContext(ctx).Scan(&oldTerms, &oldPrefixes, &oldRankedAt); err != nil && err != gocql.ErrNotFound {
+		logger.Error("failed to get existing search document", "err", err)
+		return nil, databaseError(err)
+	}
+
+	// Ranks are truncated to the precision they're stored with, so that reindexing an actor with the same rank
+	// doesn't look like a change to the rows' key
+	var rankedAt time.Time
+	switch {
+	case req.RankedAt != nil:
+		rankedAt = req.RankedAt.AsTime().UTC().Truncate(time.Millisecond)
+	case !oldRankedAt.IsZero():
+		rankedAt = oldRankedAt
+	default:
+		rankedAt = time.Now().UTC().Truncate(time.Millisecond)
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, term := range staleTerms(oldTerms, terms, false) {
+		batch.Query(`
+			DELETE FROM actor_search_terms
+			WHERE term = ? AND did = ?
+		`, term, req.Did)
+	}
+
+	for _, term := range terms {
+		batch.Query(`
+			INSERT INTO actor_search_terms
+				(term, did)
+			VALUES
+				(?, ?)
+		`, term, req.Did)
+	}
+
+	for _, prefix := range staleTerms(oldPrefixes, prefixes, !oldRankedAt.Equal(rankedAt)) {
+		batch.Query(`
+			DELETE FROM actor_typeahead
+			WHERE prefix = ? AND ranked_at = ? AND did = ?
+		`, prefix, oldRankedAt, req.Did)
+	}
+
+	for _, prefix := range prefixes {
+		batch.Query(`
+			INSERT INTO actor_typeahead
+				(prefix, ranked_at, did)
+			VALUES
+				(?, ?, ?)
+		`, prefix, rankedAt, req.Did)
+	}
+
+	batch.Query(`
+		INSERT INTO actor_search_documents
+			(did, terms, typeahead_prefixes, ranked_at)
+		VALUES
+			(?, ?, ?, ?)
+	`, req.Did, terms, prefixes, rankedAt)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to index actor", "err", err)
+		return nil, databaseError(err)
+	}
+
+	// The actor is indexed even if their prefixes can't be sized or trimmed, since the cap is only approximate
+	addedPrefixes := staleTerms(prefixes, oldPrefixes, false)
+	if err := s.resizeTypeaheadPrefixes(ctx, addedPrefixes, 1); err != nil {
+		logger.Error("failed to increment typeahead prefix sizes", "err", err)
+	}
+	if err := s.resizeTypeaheadPrefixes(ctx, staleTerms(oldPrefixes, prefixes, false), -1); err != nil {
+		logger.Error("failed to decrement typeahead prefix sizes", "err", err)
+	}
+	for _, prefix := range addedPrefixes {
+		if err := s.trimTypeaheadPrefix(ctx, prefix); err != nil {
+			logger.Error("failed to trim typeahead prefix", "prefix", prefix, "err", err)
+		}
+	}
+
+	return &vyletdatabase.IndexActorResponse{}, nil
+}
+
+// Adds delta to the number of actors indexed under each of the given typeahead prefixes
+func (s *Server) resizeTypeaheadPrefixes(ctx context.Context, prefixes []string, delta int64) error {
+	for _, prefix := range prefixes {
+		if err := s.cqlSession.Query(`
+			UPDATE actor_typeahead_sizes
+			SET size = size + ?
+			WHERE prefix = ?
+		`, delta, prefix).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to update typeahead prefix size: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Removes the lowest ranked actors from a typeahead prefix once it holds more than maxTypeaheadPrefixSize actors.
+// Trimmed prefixes are also removed from the actors' search documents, so they're counted again if the actor is
+// reindexed under them. Sizes are approximate, since concurrent indexing can race and rows that were indexed before
+// sizes were tracked aren't counted.
+func (s *Server) trimTypeaheadPrefix(ctx context.Context, prefix string) error {
+	var size int64
+	if err := s.readQuery(`
+		SELECT size
+		FROM actor_typeahead_sizes
+		WHERE prefix = ?
+	`, prefix).WithContext(ctx).Scan(&size); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to get typeahead prefix size: %w", err)
+	}
+	if size <= maxTypeaheadPrefixSize {
+		return nil
+	}
+
+	iter := s.readQuery(`
+		SELECT ranked_at, did
+		FROM actor_typeahead
+		WHERE prefix = ?
+		ORDER BY ranked_at ASC, did DESC
+		LIMIT ?
+	`, prefix, size-maxTypeaheadPrefixSize).WithContext(ctx).Iter()
+
+	var (
+		rankedAts []time.Time
+		dids      []string
+		rankedAt  time.Time
+		did       string
+	)
+	for iter.Scan(&rankedAt, &did) {
+		rankedAts = append(rankedAts, rankedAt)
+		dids = append(dids, did)
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to iterate lowest ranked actors: %w", err)
+	}
+
+	for i, did := range dids {
+		batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+		batch.Query(`
+			DELETE FROM actor_typeahead
+			WHERE prefix = ? AND ranked_at = ? AND did = ?
+		`, prefix, rankedAts[i], did)
+		batch.Query(`
+			UPDATE actor_search_documents
+			SET typeahead_prefixes = typeahead_prefixes - ?
+			WHERE did = ?
+		`, []string{prefix}, did)
+
+		if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+			return fmt.Errorf("failed to trim actor from typeahead prefix: %w", err)
+		}
+
+		if err := s.resizeTypeaheadPrefixes(ctx, []string{prefix}, -1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) DeleteActorFromIndex(ctx context.Context, req *vyletdatabase.DeleteActorFromIndexRequest) (*vyletdatabase.DeleteActorFromIndexResponse, error) {
+	logger := s.logger.With("name", "DeleteActorFromIndex", "did", req.Did)
+
+	var (
+		terms    []string
+		prefixes []string
+		rankedAt time.Time
+	)
+	if err := s.readQuery(`
+		SELECT terms, typeahead_prefixes, ranked_at
+		FROM actor_search_documents
+		WHERE did = ?
+	`, req.Did).WithContext(ctx).Scan(&terms, &prefixes, &rankedAt); err != nil {
+		if err == gocql.ErrNotFound {
+			return &vyletdatabase.DeleteActorFromIndexResponse{}, nil
+		}
+		logger.Error("failed to get search document", "err", err)
+		return nil, databaseError(err)
+	}
+
+	batch := s.cqlSession.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+
+	for _, term := range terms {
+		batch.Query(`
+			DELETE FROM actor_search_terms
+			WHERE term = ? AND did = ?
+		`, term, req.Did)
+	}
+
+	for _, prefix := range prefixes {
+		batch.Query(`
+			DELETE FROM actor_typeahead
+			WHERE prefix = ? AND ranked_at = ? AND did = ?
+		`, prefix, rankedAt, req.Did)
+	}
+
+	batch.Query(`
+		DELETE FROM actor_search_documents
+		WHERE did = ?
+	`, req.Did)
+
+	if err := s.cqlSession.ExecuteBatch(batch); err != nil {
+		logger.Error("failed to delete actor from index", "err", err)
+		return nil, databaseError(err)
+	}
+
+	if err := s.resizeTypeaheadPrefixes(ctx, prefixes, -1); err != nil {
+		logger.Error("failed to decrement typeahead prefix sizes", "err", err)
+	}
+
+	return &vyletdatabase.DeleteActorFromIndexResponse{}, nil
+}
+
+type postSearchDocument struct {
+	authorDid string
+	terms     []string
+	imageCids []string
+}
+
+func (s *Server) getPostSearchDocuments(ctx context.Context, uris []string) (map[string]*postSearchDocument, error) {
+	iter := s.readQuery(`
+		SELECT uri, author_did, terms, image_cids
+		FROM post_search_documents
+		WHERE uri IN ?
+	`, uris).WithContext(ctx).Iter()
+
+	docs := make(map[string]*postSearchDocument)
+	for {
+		var uri string
+		doc := &postSearchDocument{}
+		if !iter.Scan(&uri, &doc.authorDid, &doc.terms, &doc.imageCids) {
+			break
+		}
+		docs[uri] = doc
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to iterate search documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// Returns the CIDs of the given actors' blobs that have been taken down, by actor. Each actor's blobs are read in a
+// single query.
+func (s *Server) getTakenDownBlobs(ctx context.Context, cidsByDid map[string][]string) (map[string]map[string]struct{}, error) {
+	takenDown := make(map[string]map[string]struct{})
+	for did, cids := range cidsByDid {
+		if len(cids) == 0 {
+			continue
+		}
+
+		iter := s.readQuery(`
+			SELECT cid, taken_down
+			FROM blob_refs
+			WHERE did = ? AND cid IN ?
+		`, did, cids).WithContext(ctx).Iter()
+
+		var (
+			cid      string
+			isDown   bool
+			didBlobs = make(map[string]struct{})
+		)
+		for iter.Scan(&cid, &isDown) {
+			if isDown {
+				didBlobs[cid] = struct{}{}
+			}
+		}
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to iterate blob refs: %w", err)
+		}
+
+		if len(didBlobs) > 0 {
+			takenDown[did] = didBlobs
+		}
+	}
+
+	return takenDown, nil
+}
+
+// Posts are matched when they contain every term in the query, and are returned newest first. Posts by inactive
+// actors are filtered out, as are posts with taken down images unless the viewer is their author.
+func (s *Server) SearchPosts(ctx context.Context, req *vyletdatabase.SearchPostsRequest) (*vyletdatabase.SearchPostsResponse, error) {
+	logger := s.logger.With("name", "SearchPosts", "query", req.Query)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	terms := search.QueryTerms(req.Query)
+	if len(terms) == 0 {
+		return &vyletdatabase.SearchPostsResponse{}, nil
+	}
+	driver := search.DriverTerm(terms)
+
+	// Cursors are only valid for the query they were issued for
+	scope := cursorScope("post_search_terms", strings.Join(terms, " "))
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
+
+	// Position of the last row scanned, which the next page of terms is read after
+	after := cur
+
+	var (
+		uris      []string
+		scanned   int
+		exhausted bool
+	)
+	for len(uris) < int(req.Limit) && scanned < maxSearchScan {
+		iter := s.readPage(ctx, `
+			SELECT created_at, uri
+			FROM post_search_terms
+		`, "term = ?", []any{driver}, after, searchPageSize)
+
+		var (
+			pageUris       []string
+			pageCreatedAts []time.Time
+			createdAt      time.Time
+			uri            string
+		)
+		for iter.Scan(&createdAt, &uri) {
+			pageUris = append(pageUris, uri)
+			pageCreatedAts = append(pageCreatedAts, createdAt)
+		}
+		if err := iter.Close(); err != nil {
+			logger.Error("failed to iterate search terms", "err", err)
+			return nil, databaseError(err)
+		}
+
+		if len(pageUris) == 0 {
+			exhausted = true
+			break
+		}
+
+		docs, err := s.getPostSearchDocuments(ctx, pageUris)
+		if err != nil {
+			logger.Error("failed to get search documents", "err", err)
+			return nil, databaseError(err)
+		}
+
+		// Takedowns are looked up for the whole page at once, rather than for each matching post
+		var (
+			authorDids []string
+			cidsByDid  = make(map[string][]string)
+		)
+		for _, doc := range docs {
+			if !containsAllTerms(doc.terms, terms) {
+				continue
+			}
+			if _, ok := cidsByDid[doc.authorDid]; !ok {
+				authorDids = append(authorDids, doc.authorDid)
+				cidsByDid[doc.authorDid] = nil
+			}
+			if req.ViewerDid == nil || *req.ViewerDid != doc.authorDid {
+				cidsByDid[doc.authorDid] = append(cidsByDid[doc.authorDid], doc.imageCids...)
+			}
+		}
+
+		inactive, err := s.getInactiveActors(ctx, authorDids)
+		if err != nil {
+			logger.Error("failed to get inactive actors", "err", err)
+			return nil, databaseError(err)
+		}
+
+		takenDownBlobs, err := s.getTakenDownBlobs(ctx, cidsByDid)
+		if err != nil {
+			logger.Error("failed to get taken down images", "err", err)
+			return nil, databaseError(err)
+		}
+
+		for i, uri := range pageUris {
+			scanned++
+			after = &cursor.Cursor{Time: pageCreatedAts[i], Key: uri}
+
+			doc, ok := docs[uri]
+			if !ok || !containsAllTerms(doc.terms, terms) {
+				continue
+			}
+
+			if _, ok := inactive[doc.authorDid]; ok {
+				continue
+			}
+			if req.ViewerDid == nil || *req.ViewerDid != doc.authorDid {
+				if slices.ContainsFunc(doc.imageCids, func(cid string) bool {
+					_, ok := takenDownBlobs[doc.authorDid][cid]
+					return ok
+				}) {
+					continue
+				}
+			}
+
+			uris = append(uris, uri)
+			if len(uris) >= int(req.Limit) {
+				break
+			}
+		}
+
+		// A short page that was read to the end means there are no more rows for the term
+		if len(pageUris) < searchPageSize && after.Key == pageUris[len(pageUris)-1] {
+			exhausted = true
+			break
+		}
+	}
+
+	resp := &vyletdatabase.SearchPostsResponse{
+		Uris: uris,
+	}
+	if !exhausted {
+		resp.Cursor = s.encodeCursor(scope, after.Time, after.Key)
+	}
+
+	return resp, nil
+}
+
+// Actors are matched when they contain every term in the query, and are returned in DID order. Inactive actors are
+// filtered out.
+func (s *Server) SearchActors(ctx context.Context, req *vyletdatabase.SearchActorsRequest) (*vyletdatabase.SearchActorsResponse, error) {
+	logger := s.logger.With("name", "SearchActors", "query", req.Query)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	terms := search.QueryTerms(req.Query)
+	if len(terms) == 0 {
+		return &vyletdatabase.SearchActorsResponse{}, nil
+	}
+	driver := search.DriverTerm(terms)
+
+	scope := cursorScope("actor_search_terms", strings.Join(terms, " "))
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
+
+	var cursorDid string
+	if cur != nil {
+		cursorDid = cur.Key
+	}
+
+	var (
+		dids      []string
+		scanned   int
+		exhausted bool
+	)
+	for len(dids) < int(req.Limit) && scanned < maxSearchScan {
+		iter := s.readQuery(`
+			SELECT did
+			FROM actor_search_terms
+			WHERE term = ? AND did > ?
+			LIMIT ?
+		`, driver, cursorDid, searchPageSize).WithContext(ctx).Iter()
+
+		var (
+			pageDids []string
+			did      string
+		)
+		for iter.Scan(&did) {
+			pageDids = append(pageDids, did)
+		}
+		if err := iter.Close(); err != nil {
+			logger.Error("failed to iterate search terms", "err", err)
+			return nil, databaseError(err)
+		}
+
+		if len(pageDids) == 0 {
+			exhausted = true
+			break
+		}
+
+		docTerms := make(map[string][]string)
+		if len(terms) > 1 {
+			iter := s.readQuery(`
+				SELECT did, terms
+				FROM actor_search_documents
+				WHERE did IN ?
+			`, pageDids).WithContext(ctx).Iter()
+
+			var dTerms []string
+			for iter.Scan(&did, &dTerms) {
+				docTerms[did] = dTerms
+				dTerms = nil
+			}
+			if err := iter.Close(); err != nil {
+				logger.Error("failed to iterate search documents", "err", err)
+				return nil, databaseError(err)
+			}
+		}
+
+		inactive, err := s.getInactiveActors(ctx, pageDids)
+		if err != nil {
+			logger.Error("failed to get inactive actors", "err", err)
+			return nil, databaseError(err)
+		}
+
+		for _, did := range pageDids {
+			scanned++
+			cursorDid = did
+
+			if len(terms) > 1 && !containsAllTerms(docTerms[did], terms) {
+				continue
+			}
+			if _, ok := inactive[did]; ok {
+				continue
+			}
+
+			dids = append(dids, did)
+			if len(dids) >= int(req.Limit) {
+				break
+			}
+		}
+
+		// A short page that was read to the end means there are no more rows for the term
+		if len(pageDids) < searchPageSize && cursorDid == pageDids[len(pageDids)-1] {
+			exhausted = true
+			break
+		}
+	}
+
+	resp := &vyletdatabase.SearchActorsResponse{
+		Dids: dids,
+	}
+	if !exhausted {
+		resp.Cursor = s.encodeCursor(scope, time.Time{}, cursorDid)
+	}
+
+	return resp, nil
+}
+
+// Typeahead only reads a single partition, so it stays fast enough to run on every keystroke
+func (s *Server) SearchActorsTypeahead(ctx context.Context, req *vyletdatabase.SearchActorsTypeaheadRequest) (*vyletdatabase.SearchActorsTypeaheadResponse, error) {
+	logger := s.logger.With("name", "SearchActorsTypeahead", "query", req.Query)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	prefix := search.TypeaheadPrefix(req.Query)
+	if prefix == "" {
+		return &vyletdatabase.SearchActorsTypeaheadResponse{}, nil
+	}
+
+	iter := s.readQuery(`
+		SELECT did
+		FROM actor_typeahead
+		WHERE prefix = ?
+		LIMIT ?
+	`, prefix, req.Limit).WithContext(ctx).Iter()
+
+	var (
+		dids []string
+		did  string
+	)
+	for iter.Scan(&did) {
+		dids = append(dids, did)
+	}
+
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate typeahead", "err", err)
+		return nil, databaseError(err)
+	}
+
+	inactive, err := s.getInactiveActors(ctx, dids)
+	if err != nil {
+		logger.Error("failed to get inactive actors", "err", err)
+		return nil, databaseError(err)
+	}
+	dids = slices.DeleteFunc(dids, func(did string) bool {
+		_, ok := inactive[did]
+		return ok
+	})
+
+	return &vyletdatabase.SearchActorsTypeaheadResponse{
+		Dids: dids,
+	}, nil
+}
@@ -15,13 +15,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/vylet-app/go/database/cassandra"
+	"github.com/vylet-app/go/database/migrate"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/internal/cursor"
+	vyletmigrations "github.com/vylet-app/go/migrations"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -34,6 +39,10 @@ type Server struct {
 	vyletdatabase.UnimplementedPostServiceServer
 	vyletdatabase.UnimplementedLikeServiceServer
 	vyletdatabase.UnimplementedBlobRefServiceServer
+	vyletdatabase.UnimplementedTagServiceServer
+	vyletdatabase.UnimplementedNotificationServiceServer
+	vyletdatabase.UnimplementedSearchServiceServer
+	vyletdatabase.UnimplementedFollowServiceServer
 
 	logger *slog.Logger
 
@@ -41,9 +50,22 @@ type Server struct {
 	grpcServer   *grpc.Server
 
 	cqlSession *gocql.Session
+	cassandra  cassandra.Config
 
-	cassandraAddrs    []string
-	cassandraKeyspace string
+	cursors *cursor.Codec
+
+	blobGCGracePeriod time.Duration
+	likeCountStrategy LikeCountStrategy
+
+	// Identifies this replica in the leases it holds on background jobs
+	jobLeaseOwner string
+
+	health           *healthState
+	healthListenAddr string
+	shutdownTimeout  time.Duration
+
+	migrator    *migrate.Migrator
+	autoMigrate bool
 }
 
 type Args struct {
@@ -51,8 +73,39 @@ type Args struct {
 
 	ListenAddr string
 
-	CassandraAddrs    []string
-	CassandraKeyspace string
+	// Cassandra connection, and the consistency and policies used for reads and writes
+	Cassandra cassandra.Config
+
+	// Secret used to sign pagination cursors. Every replica must share the same secret for cursors to be usable
+	// across them. If empty, a random secret is generated and cursors are invalidated on restart.
+	CursorSecret string
+
+	// How long a blob must go unreferenced before garbage collection orphans it. Defaults to a day.
+	BlobGCGracePeriod time.Duration
+
+	// How posts' like counts are kept. Defaults to a counter.
+	LikeCountStrategy LikeCountStrategy
+
+	// Serve without TLS, for local development
+	Insecure bool
+	// PEM certificate and key the server presents. Required unless Insecure is set.
+	TLSCertFile string
+	TLSKeyFile  string
+	// PEM CA bundle that client certificates are verified against. If set, clients must use mutual TLS.
+	TLSClientCAFile string
+	// JSON file mapping client certificate common names to the RPCs they may call. Requires TLSClientCAFile.
+	AuthzPolicyFile string
+
+	// Address for the HTTP liveness and readiness endpoints. If empty, they aren't served.
+	HealthListenAddr string
+	// How long in flight RPCs are given to finish on shutdown before they're cancelled. Defaults to 30 seconds.
+	ShutdownTimeout time.Duration
+
+	// Apply pending migrations on startup. Only one replica migrates at a time, while the others wait for it. Either
+	// way the server isn't ready until every migration it was built with has been applied.
+	AutoMigrate bool
+	// Environment whose variables are rendered into migrations
+	MigrationsEnvironment string
 }
 
 func New(args *Args) (*Server, error) {
@@ -62,46 +115,100 @@ func New(args *Args) (*Server, error) {
 
 	logger := args.Logger
 
-	certificate, err := GenerateTLSCertificate("localhost")
+	creds, err := newServerCredentials(logger, args)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate TLS certificate: %w", err)
-	}
-
-	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{*certificate},
-		MinVersion:   tls.VersionTLS13,
+		return nil, err
 	}
-	creds := credentials.NewTLS(tlsConfig)
 
-	grpcServer := grpc.NewServer(
+	serverOpts := []grpc.ServerOption{
 		grpc.Creds(creds),
 		grpc.MaxConcurrentStreams(100_000),
 		grpc.ConnectionTimeout(grpcTimeout),
-	)
+	}
+
+	if args.AuthzPolicyFile != "" {
+		// Clients are identified by their certificates, so a policy can only be enforced over mutual TLS
+		if args.Insecure || args.TLSClientCAFile == "" {
+			return nil, fmt.Errorf("an authorization policy requires mutual TLS with a client CA")
+		}
+		authz, err := loadAuthorizer(args.AuthzPolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts,
+			grpc.ChainUnaryInterceptor(authz.unaryInterceptor),
+			grpc.ChainStreamInterceptor(authz.streamInterceptor),
+		)
+	} else if args.TLSClientCAFile != "" {
+		logger.Warn("no authorization policy configured, every client with a valid certificate may call every RPC")
+	}
 
-	cluster := gocql.NewCluster(args.CassandraAddrs...)
-	cluster.Keyspace = args.CassandraKeyspace
-	cluster.Consistency = gocql.Quorum
-	cluster.ProtoVersion = 4
-	cluster.ConnectTimeout = time.Second * 10
-	cluster.Timeout = time.Second * 10
+	grpcServer := grpc.NewServer(serverOpts...)
 
-	session, err := cluster.CreateSession()
+	session, err := args.Cassandra.NewSession()
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to cassandra: %w", err)
+		return nil, err
+	}
+
+	if args.BlobGCGracePeriod <= 0 {
+		args.BlobGCGracePeriod = defaultBlobGCGracePeriod
+	}
+
+	if args.LikeCountStrategy == "" {
+		args.LikeCountStrategy = LikeCountStrategyCounter
+	}
+	if !args.LikeCountStrategy.valid() {
+		return nil, fmt.Errorf("unknown like count strategy %q", args.LikeCountStrategy)
+	}
+
+	if args.ShutdownTimeout <= 0 {
+		args.ShutdownTimeout = defaultShutdownTimeout
+	}
+
+	migrator, err := migrate.New(&migrate.Args{
+		Logger:      logger,
+		Session:     session,
+		Keyspace:    args.Cassandra.Keyspace,
+		Migrations:  vyletmigrations.FS,
+		Environment: args.MigrationsEnvironment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	cursorSecret := []byte(args.CursorSecret)
+	if len(cursorSecret) == 0 {
+		logger.Warn("no cursor secret configured, generating one. cursors will not be valid across restarts or replicas")
+		cursorSecret = make([]byte, 32)
+		if _, err := rand.Read(cursorSecret); err != nil {
+			return nil, fmt.Errorf("failed to generate cursor secret: %w", err)
+		}
 	}
 
 	server := Server{
 		logger: logger,
 
-		cassandraAddrs:    args.CassandraAddrs,
-		cassandraKeyspace: args.CassandraKeyspace,
+		cassandra: args.Cassandra,
 
 		listenerAddr: args.ListenAddr,
 
 		cqlSession: session,
 
 		grpcServer: grpcServer,
+
+		cursors: cursor.NewCodec(cursorSecret),
+
+		blobGCGracePeriod: args.BlobGCGracePeriod,
+		likeCountStrategy: args.LikeCountStrategy,
+
+		jobLeaseOwner: jobLeaseOwner(),
+
+		health:           newHealthState(),
+		healthListenAddr: args.HealthListenAddr,
+		shutdownTimeout:  args.ShutdownTimeout,
+
+		migrator:    migrator,
+		autoMigrate: args.AutoMigrate,
 	}
 
 	server.registerServices()
@@ -136,6 +243,29 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}()
 
+	var healthHttpd *http.Server
+	if s.healthListenAddr != "" {
+		healthHttpd = &http.Server{
+			Addr:    s.healthListenAddr,
+			Handler: s.healthHandler(),
+		}
+		go func() {
+			logger.Info("starting health server", "addr", s.healthListenAddr)
+			if err := healthHttpd.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("health server shutdown with error", "err", err)
+			}
+		}()
+	}
+
+	// Background jobs are stopped after the gRPC server so that in flight requests can still enqueue work
+	jobsCtx, cancelJobs := context.WithCancel(ctx)
+	var jobsWg sync.WaitGroup
+	jobsWg.Go(func() { s.runHealthChecks(jobsCtx) })
+	jobsWg.Go(func() { s.runSchemaChecks(jobsCtx) })
+	jobsWg.Go(func() { s.runPostDeletionJobs(jobsCtx) })
+	jobsWg.Go(func() { s.runBlobGC(jobsCtx) })
+	jobsWg.Go(func() { s.runLikeCounts(jobsCtx) })
+
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 
@@ -148,7 +278,35 @@ func (s *Server) Run(ctx context.Context) error {
 		logger.Error("received grpc server error", "err", err)
 	}
 
-	s.grpcServer.GracefulStop()
+	// Clients watching the health service stop sending new RPCs to the server, while in flight ones are given until
+	// the shutdown timeout to finish
+	s.health.drain()
+	logger.Info("draining gRPC server", "timeout", s.shutdownTimeout)
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(s.shutdownTimeout):
+		logger.Warn("timed out waiting for in flight RPCs to finish, cancelling them")
+		s.grpcServer.Stop()
+		<-stopped
+	}
+
+	if healthHttpd != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := healthHttpd.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to shut down health server", "err", err)
+		}
+		cancel()
+	}
+
+	cancelJobs()
+	jobsWg.Wait()
 	s.cqlSession.Close()
 
 	logger.Info("gRPC server shut down")
@@ -161,9 +319,20 @@ func (s *Server) registerServices() {
 	vyletdatabase.RegisterPostServiceServer(s.grpcServer, s)
 	vyletdatabase.RegisterLikeServiceServer(s.grpcServer, s)
 	vyletdatabase.RegisterBlobRefServiceServer(s.grpcServer, s)
+	vyletdatabase.RegisterTagServiceServer(s.grpcServer, s)
+	vyletdatabase.RegisterNotificationServiceServer(s.grpcServer, s)
+	vyletdatabase.RegisterSearchServiceServer(s.grpcServer, s)
+	vyletdatabase.RegisterFollowServiceServer(s.grpcServer, s)
+	healthpb.RegisterHealthServer(s.grpcServer, s.health.server)
 	reflection.Register(s.grpcServer)
 }
 
+// Returns a query that reads, using the configured read consistency, speculative execution and retries. Every other
+// query is a write, which uses the write consistency and is never retried by the driver.
+func (s *Server) readQuery(stmt string, values ...any) *gocql.Query {
+	return s.cassandra.Read(s.cqlSession.Query(stmt, values...))
+}
+
 func GenerateTLSCertificate(commonName string) (*tls.Certificate, error) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
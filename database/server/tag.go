@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Longest window that trending tags can be counted over, which bounds the number of hourly partitions read. The
+	// API's longest window is a day.
+	maxTrendingWindowHours = 24
+	// Number of partitions each hour's tag counts are split across, so that every tag used in an hour doesn't share
+	// one partition
+	tagCountShards = 4
+	// Number of tag count partitions read at once
+	tagCountReadConcurrency = 16
+)
+
+// Returns the shard of an hour's tag counts that a tag is counted in
+func tagCountShard(tag string) int {
+	h := fnv.New32a()
+	h.Write([]byte(tag))
+	return int(h.Sum32() % tagCountShards)
+}
+
+// Counts a post's tags in the current hour. Posts are written by both the API and the indexer, and writes may be
+// retried, so each of a post's tags is only counted by whichever write first records it in post_tag_counts. Markers
+// are only written after the post itself, so a post that fails to be written is counted when it's retried.
+func (s *Server) countPostTags(ctx context.Context, postUri string, tags []string, now time.Time) error {
+	hour := now.Truncate(time.Hour)
+	for _, tag := range tags {
+		applied, err := s.cqlSession.Query(`
+			INSERT INTO post_tag_counts
+				(post_uri, tag, hour)
+			VALUES
+				(?, ?, ?)
+			IF NOT EXISTS
+		`, postUri, tag, hour).WithContext(ctx).MapScanCAS(make(map[string]any))
+		if err != nil {
+			return fmt.Errorf("failed to mark tag as counted: %w", err)
+		}
+		if !applied {
+			continue
+		}
+
+		if err := s.cqlSession.Query(`
+			UPDATE tag_counts_by_hour_shard
+			SET post_count = post_count + 1
+			WHERE hour = ? AND shard = ? AND tag = ?
+		`, hour, tagCountShard(tag), tag).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to increment tag count: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Removes a deleted post's tags from the hours they were counted in. Each tag is only uncounted by whichever delete
+// removes its marker, so retried deletes don't uncount it twice.
+func (s *Server) uncountPostTags(ctx context.Context, postUri string) error {
+	iter := s.readQuery(`
+		SELECT tag, hour
+		FROM post_tag_counts
+		WHERE post_uri = ?
+	`, postUri).WithContext(ctx).Iter()
+
+	var (
+		tags  []string
+		hours []time.Time
+		tag   string
+		hour  time.Time
+	)
+	for iter.Scan(&tag, &hour) {
+		tags = append(tags, tag)
+		hours = append(hours, hour)
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to iterate counted tags: %w", err)
+	}
+
+	for i, tag := range tags {
+		applied, err := s.cqlSession.Query(`
+			DELETE FROM post_tag_counts
+			WHERE post_uri = ? AND tag = ?
+			IF EXISTS
+		`, postUri, tag).WithContext(ctx).MapScanCAS(make(map[string]any))
+		if err != nil {
+			return fmt.Errorf("failed to unmark counted tag: %w", err)
+		}
+		if !applied {
+			continue
+		}
+
+		if err := s.cqlSession.Query(`
+			UPDATE tag_counts_by_hour_shard
+			SET post_count = post_count - 1
+			WHERE hour = ? AND shard = ? AND tag = ?
+		`, hours[i], tagCountShard(tag), tag).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to decrement tag count: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) GetPostsByTag(ctx context.Context, req *vyletdatabase.GetPostsByTagRequest) (*vyletdatabase.GetPostsByTagResponse, error) {
+	logger := s.logger.With("name", "GetPostsByTag", "tag", req.Tag)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	scope := cursorScope("posts_by_tag", req.Tag)
+	cur, err := s.decodeCursor(scope, req.Cursor)
+	if err != nil {
+		logger.Error("failed to decode cursor", "cursor", *req.Cursor, "err", err)
+		return nil, invalidCursorError()
+	}
+
+	iter := s.readPage(ctx, `
+		SELECT uri, created_at
+		FROM posts_by_tag
+	`, "tag = ?", []any{req.Tag}, cur, int(req.Limit)+1)
+	defer iter.Close()
+
+	var (
+		uris       []string
+		createdAts []time.Time
+	)
+	for {
+		var (
+			uri       string
+			createdAt time.Time
+		)
+		if !iter.Scan(&uri, &createdAt) {
+			break
+		}
+		uris = append(uris, uri)
+		createdAts = append(createdAts, createdAt)
+	}
+
+	if err := iter.Close(); err != nil {
+		logger.Error("failed to iterate tagged posts", "err", err)
+		return nil, databaseError(err)
+	}
+
+	var nextCursor *string
+	if len(uris) > int(req.Limit) {
+		uris = uris[:req.Limit]
+		nextCursor = s.encodeCursor(scope, createdAts[len(uris)-1], uris[len(uris)-1])
+	}
+
+	if len(uris) == 0 {
+		return &vyletdatabase.GetPostsByTagResponse{}, nil
+	}
+
+	posts, err := s.getPostsByUris(ctx, uris)
+	if err != nil {
+		logger.Error("failed to get tagged posts", "err", err)
+		return nil, databaseError(err)
+	}
+
+	return &vyletdatabase.GetPostsByTagResponse{
+		Posts:  posts,
+		Cursor: nextCursor,
+	}, nil
+}
+
+// Trending tags are counted in hourly buckets, each split into shards, and the window slides an hour at a time by
+// summing the buckets that fall inside of it. The current, partial, hour is always included.
+func (s *Server) GetTrendingTags(ctx context.Context, req *vyletdatabase.GetTrendingTagsRequest) (*vyletdatabase.GetTrendingTagsResponse, error) {
+	logger := s.logger.With("name", "GetTrendingTags", "windowHours", req.WindowHours)
+
+	if req.Limit <= 0 {
+		return nil, invalidArgumentError("limit must be greater than 0")
+	}
+
+	if req.WindowHours <= 0 || req.WindowHours > maxTrendingWindowHours {
+		return nil, invalidArgumentError("window must be between 1 and %d hours", maxTrendingWindowHours)
+	}
+
+	var (
+		lk     sync.Mutex
+		counts = make(map[string]int64)
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(tagCountReadConcurrency)
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	for i := range req.WindowHours {
+		hour := now.Add(-time.Duration(i) * time.Hour)
+		for shard := range tagCountShards {
+			g.Go(func() error {
+				iter := s.readQuery(`
+					SELECT tag, post_count
+					FROM tag_counts_by_hour_shard
+					WHERE hour = ? AND shard = ?
+				`, hour, shard).WithContext(gCtx).Iter()
+
+				var (
+					shardCounts = make(map[string]int64)
+					tag         string
+					count       int64
+				)
+				for iter.Scan(&tag, &count) {
+					shardCounts[tag] += count
+				}
+				if err := iter.Close(); err != nil {
+					logger.Error("failed to iterate tag counts", "hour", hour, "shard", shard, "err", err)
+					return err
+				}
+
+				lk.Lock()
+				defer lk.Unlock()
+				for tag, count := range shardCounts {
+					counts[tag] += count
+				}
+				return nil
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, databaseError(err)
+	}
+
+	tags := make([]*vyletdatabase.TrendingTag, 0, len(counts))
+	for tag, count := range counts {
+		if count <= 0 {
+			continue
+		}
+		tags = append(tags, &vyletdatabase.TrendingTag{
+			Tag:   tag,
+			Count: count,
+		})
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+
+	if len(tags) > int(req.Limit) {
+		tags = tags[:req.Limit]
+	}
+
+	return &vyletdatabase.GetTrendingTagsResponse{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Builds the SET clause of an update from the columns that may be updated, keyed by their field mask path. Paths are
+// the proto field names, which are the same as the column names. A nil mask updates every column, for callers that
+// replace the whole row, while a path that isn't an updatable column is an InvalidArgument error. Returns the
+// assignments and their values in a stable order.
+func maskedAssignments(mask *fieldmaskpb.FieldMask, columns map[string]any) ([]string, []any, error) {
+	var paths []string
+	if mask == nil {
+		for path := range columns {
+			paths = append(paths, path)
+		}
+	} else {
+		mask.Normalize()
+		for _, path := range mask.GetPaths() {
+			if _, ok := columns[path]; !ok {
+				return nil, nil, invalidArgumentError("field %q cannot be updated", path)
+			}
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	assignments := make([]string, 0, len(paths))
+	values := make([]any, 0, len(paths))
+	for _, path := range paths {
+		assignments = append(assignments, path+" = ?")
+		values = append(values, columns[path])
+	}
+
+	return assignments, values, nil
+}
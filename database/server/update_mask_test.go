@@ -0,0 +1,94 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestMaskedAssignments(t *testing.T) {
+	columns := map[string]any{
+		"display_name": "alice",
+		"description":  "hello",
+		"avatar":       "bafkrei",
+	}
+
+	tests := []struct {
+		name            string
+		mask            *fieldmaskpb.FieldMask
+		wantAssignments []string
+		wantValues      []any
+		wantInvalid     bool
+	}{
+		{
+			name:            "nil mask updates every column",
+			mask:            nil,
+			wantAssignments: []string{"avatar = ?", "description = ?", "display_name = ?"},
+			wantValues:      []any{"bafkrei", "hello", "alice"},
+		},
+		{
+			name:            "empty mask updates nothing",
+			mask:            &fieldmaskpb.FieldMask{},
+			wantAssignments: []string{},
+			wantValues:      []any{},
+		},
+		{
+			name:            "single path",
+			mask:            &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+			wantAssignments: []string{"description = ?"},
+			wantValues:      []any{"hello"},
+		},
+		{
+			name:            "paths are sorted",
+			mask:            &fieldmaskpb.FieldMask{Paths: []string{"display_name", "avatar"}},
+			wantAssignments: []string{"avatar = ?", "display_name = ?"},
+			wantValues:      []any{"bafkrei", "alice"},
+		},
+		{
+			name:            "duplicate paths are normalized away",
+			mask:            &fieldmaskpb.FieldMask{Paths: []string{"avatar", "avatar"}},
+			wantAssignments: []string{"avatar = ?"},
+			wantValues:      []any{"bafkrei"},
+		},
+		{
+			name:        "unknown path",
+			mask:        &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+			wantInvalid: true,
+		},
+		{
+			name:        "unknown path alongside known paths",
+			mask:        &fieldmaskpb.FieldMask{Paths: []string{"avatar", "did"}},
+			wantInvalid: true,
+		},
+		{
+			name:        "camel case path",
+			mask:        &fieldmaskpb.FieldMask{Paths: []string{"displayName"}},
+			wantInvalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignments, values, err := maskedAssignments(tt.mask, columns)
+			if tt.wantInvalid {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("got error %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Equal(assignments, tt.wantAssignments) {
+				t.Errorf("got assignments %q, want %q", assignments, tt.wantAssignments)
+			}
+			if !slices.Equal(values, tt.wantValues) {
+				t.Errorf("got values %v, want %v", values, tt.wantValues)
+			}
+		})
+	}
+}
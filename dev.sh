@@ -77,7 +77,7 @@ fi
 print_success "Migrations completed"
 
 print_status "Starting database server on :9090..."
-go run ./cmd/database &
+go run ./cmd/database --insecure &
 DATABASE_PID=$!
 sleep 3
 
@@ -102,7 +102,7 @@ fi
 print_success "Firehose running (PID: $FIREHOSE_PID)"
 
 print_status "Starting indexer (consuming from Kafka)..."
-go run ./cmd/indexer &
+go run ./cmd/indexer --database-insecure &
 INDEXER_PID=$!
 sleep 3
 
@@ -115,7 +115,7 @@ fi
 print_success "Indexer running (PID: $INDEXER_PID)"
 
 print_status "Starting CDN (tracking blob references)..."
-go run ./cmd/cdn &
+go run ./cmd/cdn --database-insecure &
 CDN_PID=$!
 sleep 3
 
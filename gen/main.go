@@ -28,6 +28,7 @@ func main() {
 		vylet.RichtextFacet_ByteSlice{},
 		vylet.RichtextFacet_Link{},
 		vylet.RichtextFacet_Mention{},
+		vylet.RichtextFacet_Tag{},
 		vylet.MediaImages{},
 		vylet.MediaImages_Image{},
 		vylet.MediaDefs_AspectRatio{},
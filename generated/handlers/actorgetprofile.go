@@ -17,8 +17,12 @@ func (h *Handlers) HandleActorGetProfile(e echo.Context) error {
 	var input ActorGetProfileInput
 	if err := e.Bind(&input); err != nil {
 		logger := h.server.Logger().With("handler", "HandleActorGetProfile")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
 	}
 
 	output, err := h.server.HandleActorGetProfile(e, &input)
@@ -28,3 +32,17 @@ func (h *Handlers) HandleActorGetProfile(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, &output)
 }
+
+func (input *ActorGetProfileInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Actor == "" {
+		errs = append(errs, ValidationError{Field: "actor", Message: "actor is required"})
+	} else {
+		if !validFormat("at-identifier", input.Actor) {
+			errs = append(errs, ValidationError{Field: "actor", Message: "actor must be a valid DID or handle"})
+		}
+	}
+
+	return errs
+}
@@ -17,8 +17,12 @@ func (h *Handlers) HandleActorGetProfiles(e echo.Context) error {
 	var input ActorGetProfilesInput
 	if err := e.Bind(&input); err != nil {
 		logger := h.server.Logger().With("handler", "HandleActorGetProfiles")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
 	}
 
 	output, err := h.server.HandleActorGetProfiles(e, &input)
@@ -28,3 +32,23 @@ func (h *Handlers) HandleActorGetProfiles(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, &output)
 }
+
+func (input *ActorGetProfilesInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if len(input.Dids) == 0 {
+		errs = append(errs, ValidationError{Field: "dids", Message: "dids is required"})
+	} else {
+		if len(input.Dids) > 25 {
+			errs = append(errs, ValidationError{Field: "dids", Message: "dids must contain at most 25 items"})
+		}
+		for _, item := range input.Dids {
+			if !validFormat("did", item) {
+				errs = append(errs, ValidationError{Field: "dids", Message: "each item in dids must be a valid DID"})
+				break
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,61 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ActorSearchActorsTypeaheadInput struct {
+	Limit *int64 `query:"limit"`
+	Q string `query:"q"`
+}
+
+func (h *Handlers) HandleActorSearchActorsTypeahead(e echo.Context) error {
+	var input ActorSearchActorsTypeaheadInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "HandleActorSearchActorsTypeahead")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+
+	output, err := h.server.HandleActorSearchActorsTypeahead(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+
+func (input *ActorSearchActorsTypeaheadInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Limit == nil {
+		defaultLimit := int64(10)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 25 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 25"})
+		}
+	}
+
+	if input.Q == "" {
+		errs = append(errs, ValidationError{Field: "q", Message: "q is required"})
+	} else {
+		if len(input.Q) < 1 {
+			errs = append(errs, ValidationError{Field: "q", Message: "q must be at least 1 bytes long"})
+		}
+		if len(input.Q) > 100 {
+			errs = append(errs, ValidationError{Field: "q", Message: "q must be at most 100 bytes long"})
+		}
+	}
+
+	return errs
+}
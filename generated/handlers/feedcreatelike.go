@@ -0,0 +1,29 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	vylet "github.com/vylet-app/go/generated/vylet"
+)
+
+func (h *Handlers) HandleFeedCreateLike(e echo.Context) error {
+	logger := h.server.Logger().With("handler", "HandleFeedCreateLike")
+
+	var input vylet.FeedCreateLike_Input
+	if err := json.NewDecoder(e.Request().Body).Decode(&input); err != nil {
+		logger.Warn("error decoding request body", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid request body")
+	}
+
+	output, err := h.server.HandleFeedCreateLike(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
@@ -0,0 +1,65 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FeedGetActorLikesInput struct {
+	Actor string `query:"actor"`
+	Cursor *string `query:"cursor"`
+	Limit *int64 `query:"limit"`
+}
+
+func (h *Handlers) HandleFeedGetActorLikes(e echo.Context) error {
+	var input FeedGetActorLikesInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "HandleFeedGetActorLikes")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+
+	output, err := h.server.HandleFeedGetActorLikes(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+
+func (input *FeedGetActorLikesInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Actor == "" {
+		errs = append(errs, ValidationError{Field: "actor", Message: "actor is required"})
+	} else {
+		if !validFormat("at-identifier", input.Actor) {
+			errs = append(errs, ValidationError{Field: "actor", Message: "actor must be a valid DID or handle"})
+		}
+	}
+
+	if input.Limit == nil {
+		defaultLimit := int64(25)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 100 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 100"})
+		}
+	}
+
+	return errs
+}
+
+// Errors declared by the lexicon
+const (
+	// Indicates that likes are not public and the actor is not the authenticated account.
+	FeedGetActorLikesErrorRequesterNotActor = "RequesterNotActor"
+)
@@ -19,8 +19,12 @@ func (h *Handlers) HandleFeedGetActorPosts(e echo.Context) error {
 	var input FeedGetActorPostsInput
 	if err := e.Bind(&input); err != nil {
 		logger := h.server.Logger().With("handler", "HandleFeedGetActorPosts")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
 	}
 
 	output, err := h.server.HandleFeedGetActorPosts(e, &input)
@@ -30,3 +34,26 @@ func (h *Handlers) HandleFeedGetActorPosts(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, &output)
 }
+
+func (input *FeedGetActorPostsInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Actor == "" {
+		errs = append(errs, ValidationError{Field: "actor", Message: "actor is required"})
+	} else {
+		if !validFormat("at-identifier", input.Actor) {
+			errs = append(errs, ValidationError{Field: "actor", Message: "actor must be a valid DID or handle"})
+		}
+	}
+
+	if input.Limit == nil {
+		defaultLimit := int64(25)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 100 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 100"})
+		}
+	}
+
+	return errs
+}
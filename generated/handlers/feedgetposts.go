@@ -17,8 +17,12 @@ func (h *Handlers) HandleFeedGetPosts(e echo.Context) error {
 	var input FeedGetPostsInput
 	if err := e.Bind(&input); err != nil {
 		logger := h.server.Logger().With("handler", "HandleFeedGetPosts")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
 	}
 
 	output, err := h.server.HandleFeedGetPosts(e, &input)
@@ -28,3 +32,23 @@ func (h *Handlers) HandleFeedGetPosts(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, &output)
 }
+
+func (input *FeedGetPostsInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if len(input.Uris) == 0 {
+		errs = append(errs, ValidationError{Field: "uris", Message: "uris is required"})
+	} else {
+		if len(input.Uris) > 25 {
+			errs = append(errs, ValidationError{Field: "uris", Message: "uris must contain at most 25 items"})
+		}
+		for _, item := range input.Uris {
+			if !validFormat("at-uri", item) {
+				errs = append(errs, ValidationError{Field: "uris", Message: "each item in uris must be a valid AT-URI"})
+				break
+			}
+		}
+	}
+
+	return errs
+}
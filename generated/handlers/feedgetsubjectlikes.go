@@ -11,6 +11,7 @@ import (
 
 type FeedGetSubjectLikesInput struct {
 	Cursor *string `query:"cursor"`
+	IncludeSubject *bool `query:"includeSubject"`
 	Limit *int64 `query:"limit"`
 	Uri string `query:"uri"`
 }
@@ -19,8 +20,12 @@ func (h *Handlers) HandleFeedGetSubjectLikes(e echo.Context) error {
 	var input FeedGetSubjectLikesInput
 	if err := e.Bind(&input); err != nil {
 		logger := h.server.Logger().With("handler", "HandleFeedGetSubjectLikes")
-		logger.Error("error binding request", "err", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
 	}
 
 	output, err := h.server.HandleFeedGetSubjectLikes(e, &input)
@@ -30,3 +35,31 @@ func (h *Handlers) HandleFeedGetSubjectLikes(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, &output)
 }
+
+func (input *FeedGetSubjectLikesInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.IncludeSubject == nil {
+		defaultIncludeSubject := false
+		input.IncludeSubject = &defaultIncludeSubject
+	}
+
+	if input.Limit == nil {
+		defaultLimit := int64(25)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 100 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 100"})
+		}
+	}
+
+	if input.Uri == "" {
+		errs = append(errs, ValidationError{Field: "uri", Message: "uri is required"})
+	} else {
+		if !validFormat("at-uri", input.Uri) {
+			errs = append(errs, ValidationError{Field: "uri", Message: "uri must be a valid AT-URI"})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,62 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FeedGetTagPostsInput struct {
+	Cursor *string `query:"cursor"`
+	Limit *int64 `query:"limit"`
+	Tag string `query:"tag"`
+}
+
+func (h *Handlers) HandleFeedGetTagPosts(e echo.Context) error {
+	var input FeedGetTagPostsInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "HandleFeedGetTagPosts")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+
+	output, err := h.server.HandleFeedGetTagPosts(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+
+func (input *FeedGetTagPostsInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Limit == nil {
+		defaultLimit := int64(25)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 100 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 100"})
+		}
+	}
+
+	if input.Tag == "" {
+		errs = append(errs, ValidationError{Field: "tag", Message: "tag is required"})
+	} else {
+		if len(input.Tag) < 1 {
+			errs = append(errs, ValidationError{Field: "tag", Message: "tag must be at least 1 bytes long"})
+		}
+		if len(input.Tag) > 640 {
+			errs = append(errs, ValidationError{Field: "tag", Message: "tag must be at most 640 bytes long"})
+		}
+	}
+
+	return errs
+}
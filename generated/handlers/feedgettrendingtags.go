@@ -0,0 +1,61 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FeedGetTrendingTagsInput struct {
+	Limit *int64 `query:"limit"`
+	Window *string `query:"window"`
+}
+
+func (h *Handlers) HandleFeedGetTrendingTags(e echo.Context) error {
+	var input FeedGetTrendingTagsInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "HandleFeedGetTrendingTags")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	if errs := input.validate(); len(errs) > 0 {
+		return NewValidationErrors(errs...)
+	}
+
+	output, err := h.server.HandleFeedGetTrendingTags(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+
+func (input *FeedGetTrendingTagsInput) validate() []ValidationError {
+	var errs []ValidationError
+
+	if input.Limit == nil {
+		defaultLimit := int64(10)
+		input.Limit = &defaultLimit
+	} else {
+		if *input.Limit < 1 || *input.Limit > 50 {
+			errs = append(errs, ValidationError{Field: "limit", Message: "limit must be between 1 and 50"})
+		}
+	}
+
+	if input.Window == nil {
+		defaultWindow := "24h"
+		input.Window = &defaultWindow
+	} else {
+		switch *input.Window {
+		case "1h", "6h", "24h":
+		default:
+			errs = append(errs, ValidationError{Field: "window", Message: "window must be one of: 1h, 6h, 24h"})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,35 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	vylet "github.com/vylet-app/go/generated/vylet"
+)
+
+func (h *Handlers) HandleGraphCreateFollow(e echo.Context) error {
+	logger := h.server.Logger().With("handler", "HandleGraphCreateFollow")
+
+	var input vylet.GraphCreateFollow_Input
+	if err := json.NewDecoder(e.Request().Body).Decode(&input); err != nil {
+		logger.Warn("error decoding request body", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid request body")
+	}
+
+	output, err := h.server.HandleGraphCreateFollow(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
+
+// Errors declared by the lexicon
+const (
+	// Indicates that the subject is the authenticated account.
+	GraphCreateFollowErrorSelfFollow = "SelfFollow"
+)
@@ -4,6 +4,7 @@
 package handlers
 
 import (
+	"io"
 	"log/slog"
 	"net/http"
 
@@ -18,12 +19,40 @@ type Server interface {
 	ActorGetProfileRequiresAuth() bool
 	HandleActorGetProfiles(e echo.Context, input *ActorGetProfilesInput) (*vylet.ActorGetProfiles_Output, *echo.HTTPError)
 	ActorGetProfilesRequiresAuth() bool
+	HandleActorSearchActors(e echo.Context, input *ActorSearchActorsInput) (*vylet.ActorSearchActors_Output, *echo.HTTPError)
+	ActorSearchActorsRequiresAuth() bool
+	HandleActorSearchActorsTypeahead(e echo.Context, input *ActorSearchActorsTypeaheadInput) (*vylet.ActorSearchActorsTypeahead_Output, *echo.HTTPError)
+	ActorSearchActorsTypeaheadRequiresAuth() bool
+	HandleFeedGetActorLikes(e echo.Context, input *FeedGetActorLikesInput) (*vylet.FeedGetActorLikes_Output, *echo.HTTPError)
+	FeedGetActorLikesRequiresAuth() bool
 	HandleFeedGetActorPosts(e echo.Context, input *FeedGetActorPostsInput) (*vylet.FeedGetActorPosts_Output, *echo.HTTPError)
 	FeedGetActorPostsRequiresAuth() bool
 	HandleFeedGetPosts(e echo.Context, input *FeedGetPostsInput) (*vylet.FeedGetPosts_Output, *echo.HTTPError)
 	FeedGetPostsRequiresAuth() bool
 	HandleFeedGetSubjectLikes(e echo.Context, input *FeedGetSubjectLikesInput) (*vylet.FeedGetSubjectLikes_Output, *echo.HTTPError)
 	FeedGetSubjectLikesRequiresAuth() bool
+	HandleFeedGetTagPosts(e echo.Context, input *FeedGetTagPostsInput) (*vylet.FeedGetTagPosts_Output, *echo.HTTPError)
+	FeedGetTagPostsRequiresAuth() bool
+	HandleFeedGetTrendingTags(e echo.Context, input *FeedGetTrendingTagsInput) (*vylet.FeedGetTrendingTags_Output, *echo.HTTPError)
+	FeedGetTrendingTagsRequiresAuth() bool
+	HandleFeedSearchPosts(e echo.Context, input *FeedSearchPostsInput) (*vylet.FeedSearchPosts_Output, *echo.HTTPError)
+	FeedSearchPostsRequiresAuth() bool
+	HandleNotificationGetUnreadCount(e echo.Context, input *NotificationGetUnreadCountInput) (*vylet.NotificationGetUnreadCount_Output, *echo.HTTPError)
+	NotificationGetUnreadCountRequiresAuth() bool
+	HandleNotificationListNotifications(e echo.Context, input *NotificationListNotificationsInput) (*vylet.NotificationListNotifications_Output, *echo.HTTPError)
+	NotificationListNotificationsRequiresAuth() bool
+	HandleFeedCreateLike(e echo.Context, input *vylet.FeedCreateLike_Input) (*vylet.FeedCreateLike_Output, *echo.HTTPError)
+	FeedCreateLikeRequiresAuth() bool
+	HandleFeedCreatePost(e echo.Context, input *vylet.FeedCreatePost_Input) (*vylet.FeedCreatePost_Output, *echo.HTTPError)
+	FeedCreatePostRequiresAuth() bool
+	HandleGraphCreateFollow(e echo.Context, input *vylet.GraphCreateFollow_Input) (*vylet.GraphCreateFollow_Output, *echo.HTTPError)
+	GraphCreateFollowRequiresAuth() bool
+	HandleMediaUploadBlob(e echo.Context, input io.Reader) (*vylet.MediaUploadBlob_Output, *echo.HTTPError)
+	MediaUploadBlobRequiresAuth() bool
+	HandleNotificationUpdateSeen(e echo.Context, input *vylet.NotificationUpdateSeen_Input) *echo.HTTPError
+	NotificationUpdateSeenRequiresAuth() bool
+	HandleRepoDeleteRecords(e echo.Context, input *vylet.RepoDeleteRecords_Input) *echo.HTTPError
+	RepoDeleteRecordsRequiresAuth() bool
 }
 
 type Handlers struct {
@@ -37,16 +66,30 @@ func RegisterHandlers(e *echo.Echo, s Server) {
 
 	e.GET("/xrpc/app.vylet.actor.getProfile", h.HandleActorGetProfile, CreateAuthRequiredMiddleware(s.ActorGetProfileRequiresAuth()))
 	e.GET("/xrpc/app.vylet.actor.getProfiles", h.HandleActorGetProfiles, CreateAuthRequiredMiddleware(s.ActorGetProfilesRequiresAuth()))
+	e.GET("/xrpc/app.vylet.actor.searchActors", h.HandleActorSearchActors, CreateAuthRequiredMiddleware(s.ActorSearchActorsRequiresAuth()))
+	e.GET("/xrpc/app.vylet.actor.searchActorsTypeahead", h.HandleActorSearchActorsTypeahead, CreateAuthRequiredMiddleware(s.ActorSearchActorsTypeaheadRequiresAuth()))
+	e.GET("/xrpc/app.vylet.feed.getActorLikes", h.HandleFeedGetActorLikes, CreateAuthRequiredMiddleware(s.FeedGetActorLikesRequiresAuth()))
 	e.GET("/xrpc/app.vylet.feed.getActorPosts", h.HandleFeedGetActorPosts, CreateAuthRequiredMiddleware(s.FeedGetActorPostsRequiresAuth()))
 	e.GET("/xrpc/app.vylet.feed.getPosts", h.HandleFeedGetPosts, CreateAuthRequiredMiddleware(s.FeedGetPostsRequiresAuth()))
 	e.GET("/xrpc/app.vylet.feed.getSubjectLikes", h.HandleFeedGetSubjectLikes, CreateAuthRequiredMiddleware(s.FeedGetSubjectLikesRequiresAuth()))
+	e.GET("/xrpc/app.vylet.feed.getTagPosts", h.HandleFeedGetTagPosts, CreateAuthRequiredMiddleware(s.FeedGetTagPostsRequiresAuth()))
+	e.GET("/xrpc/app.vylet.feed.getTrendingTags", h.HandleFeedGetTrendingTags, CreateAuthRequiredMiddleware(s.FeedGetTrendingTagsRequiresAuth()))
+	e.GET("/xrpc/app.vylet.feed.searchPosts", h.HandleFeedSearchPosts, CreateAuthRequiredMiddleware(s.FeedSearchPostsRequiresAuth()))
+	e.GET("/xrpc/app.vylet.notification.getUnreadCount", h.HandleNotificationGetUnreadCount, CreateAuthRequiredMiddleware(s.NotificationGetUnreadCountRequiresAuth()))
+	e.GET("/xrpc/app.vylet.notification.listNotifications", h.HandleNotificationListNotifications, CreateAuthRequiredMiddleware(s.NotificationListNotificationsRequiresAuth()))
+	e.POST("/xrpc/app.vylet.feed.createLike", h.HandleFeedCreateLike, CreateAuthRequiredMiddleware(s.FeedCreateLikeRequiresAuth()))
+	e.POST("/xrpc/app.vylet.feed.createPost", h.HandleFeedCreatePost, CreateAuthRequiredMiddleware(s.FeedCreatePostRequiresAuth()))
+	e.POST("/xrpc/app.vylet.graph.createFollow", h.HandleGraphCreateFollow, CreateAuthRequiredMiddleware(s.GraphCreateFollowRequiresAuth()))
+	e.POST("/xrpc/app.vylet.media.uploadBlob", h.HandleMediaUploadBlob, CreateAuthRequiredMiddleware(s.MediaUploadBlobRequiresAuth()))
+	e.POST("/xrpc/app.vylet.notification.updateSeen", h.HandleNotificationUpdateSeen, CreateAuthRequiredMiddleware(s.NotificationUpdateSeenRequiresAuth()))
+	e.POST("/xrpc/app.vylet.repo.deleteRecords", h.HandleRepoDeleteRecords, CreateAuthRequiredMiddleware(s.RepoDeleteRecordsRequiresAuth()))
 }
 
 func AuthRequiredMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(e echo.Context) error {
 		viewer, ok := e.Get("viewer").(string)
 		if !ok || viewer == "" {
-			return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+			return NewXRPCError(http.StatusUnauthorized, ErrorAuthRequired, "Authentication required")
 		}
 		return next(e)
 	}
@@ -0,0 +1,22 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handlers) HandleMediaUploadBlob(e echo.Context) error {
+	input := e.Request().Body
+	defer input.Close()
+
+	output, err := h.server.HandleMediaUploadBlob(e, input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
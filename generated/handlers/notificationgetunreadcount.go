@@ -0,0 +1,29 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationGetUnreadCountInput struct {
+}
+
+func (h *Handlers) HandleNotificationGetUnreadCount(e echo.Context) error {
+	var input NotificationGetUnreadCountInput
+	if err := e.Bind(&input); err != nil {
+		logger := h.server.Logger().With("handler", "HandleNotificationGetUnreadCount")
+		logger.Warn("error binding request", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid query parameters")
+	}
+
+	output, err := h.server.HandleNotificationGetUnreadCount(e, &input)
+	if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, &output)
+}
@@ -0,0 +1,28 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	vylet "github.com/vylet-app/go/generated/vylet"
+)
+
+func (h *Handlers) HandleNotificationUpdateSeen(e echo.Context) error {
+	logger := h.server.Logger().With("handler", "HandleNotificationUpdateSeen")
+
+	var input vylet.NotificationUpdateSeen_Input
+	if err := json.NewDecoder(e.Request().Body).Decode(&input); err != nil {
+		logger.Warn("error decoding request body", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid request body")
+	}
+
+	if err := h.server.HandleNotificationUpdateSeen(e, &input); err != nil {
+		return err
+	}
+
+	return e.NoContent(http.StatusOK)
+}
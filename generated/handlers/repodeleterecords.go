@@ -0,0 +1,36 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	vylet "github.com/vylet-app/go/generated/vylet"
+)
+
+func (h *Handlers) HandleRepoDeleteRecords(e echo.Context) error {
+	logger := h.server.Logger().With("handler", "HandleRepoDeleteRecords")
+
+	var input vylet.RepoDeleteRecords_Input
+	if err := json.NewDecoder(e.Request().Body).Decode(&input); err != nil {
+		logger.Warn("error decoding request body", "err", err)
+		return NewXRPCError(http.StatusBadRequest, ErrorInvalidRequest, "Invalid request body")
+	}
+
+	if err := h.server.HandleRepoDeleteRecords(e, &input); err != nil {
+		return err
+	}
+
+	return e.NoContent(http.StatusOK)
+}
+
+// Errors declared by the lexicon
+const (
+	// Indicates that one of the records is not in the authenticated account's repository.
+	RepoDeleteRecordsErrorNotRecordOwner = "NotRecordOwner"
+	// Indicates that one of the records is in a collection that can't be deleted through the API.
+	RepoDeleteRecordsErrorUnsupportedCollection = "UnsupportedCollection"
+)
@@ -0,0 +1,38 @@
+// GENERATED CODE - DO NOT MODIFY
+// Generated by vylet-app/handlergen
+
+package handlers
+
+import (
+	"github.com/bluesky-social/indigo/atproto/syntax"
+)
+
+// Reports whether the value is valid for the given lexicon string format.
+func validFormat(format, value string) bool {
+	var err error
+	switch format {
+	case "at-identifier":
+		_, err = syntax.ParseAtIdentifier(value)
+	case "at-uri":
+		_, err = syntax.ParseATURI(value)
+	case "cid":
+		_, err = syntax.ParseCID(value)
+	case "datetime":
+		_, err = syntax.ParseDatetime(value)
+	case "did":
+		_, err = syntax.ParseDID(value)
+	case "handle":
+		_, err = syntax.ParseHandle(value)
+	case "language":
+		_, err = syntax.ParseLanguage(value)
+	case "nsid":
+		_, err = syntax.ParseNSID(value)
+	case "record-key":
+		_, err = syntax.ParseRecordKey(value)
+	case "tid":
+		_, err = syntax.ParseTID(value)
+	case "uri":
+		_, err = syntax.ParseURI(value)
+	}
+	return err == nil
+}
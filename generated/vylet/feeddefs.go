@@ -71,6 +71,13 @@ func (t *FeedDefs_PostView_Media) UnmarshalJSON(b []byte) error {
 	}
 }
 
+// FeedDefs_TrendingTag is a "trendingTag" in the app.vylet.feed.defs schema.
+type FeedDefs_TrendingTag struct {
+	// count: Number of posts using the tag within the requested window.
+	Count int64  `json:"count" cborgen:"count"`
+	Tag   string `json:"tag" cborgen:"tag"`
+}
+
 // FeedDefs_ViewerState is a "viewerState" in the app.vylet.feed.defs schema.
 //
 // Metadata about the requesting account's relationship with the subject content. Only has meaningful content for authed requests.
@@ -13,25 +13,33 @@ import (
 // FeedGetSubjectLikes_Like is a "like" in the app.vylet.feed.getSubjectLikes schema.
 type FeedGetSubjectLikes_Like struct {
 	Actor     *ActorDefs_ProfileView `json:"actor" cborgen:"actor"`
+	Cid       string                 `json:"cid" cborgen:"cid"`
 	CreatedAt string                 `json:"createdAt" cborgen:"createdAt"`
 	IndexedAt string                 `json:"indexedAt" cborgen:"indexedAt"`
+	Uri       string                 `json:"uri" cborgen:"uri"`
 }
 
 // FeedGetSubjectLikes_Output is the output of a app.vylet.feed.getSubjectLikes call.
 type FeedGetSubjectLikes_Output struct {
-	Cursor *string                     `json:"cursor,omitempty" cborgen:"cursor,omitempty"`
-	Likes  []*FeedGetSubjectLikes_Like `json:"likes" cborgen:"likes"`
-	Uri    string                      `json:"uri" cborgen:"uri"`
+	Cursor  *string                     `json:"cursor,omitempty" cborgen:"cursor,omitempty"`
+	Likes   []*FeedGetSubjectLikes_Like `json:"likes" cborgen:"likes"`
+	Subject *FeedDefs_PostView          `json:"subject,omitempty" cborgen:"subject,omitempty"`
+	Uri     string                      `json:"uri" cborgen:"uri"`
 }
 
 // FeedGetSubjectLikes calls the XRPC method "app.vylet.feed.getSubjectLikes".
-func FeedGetSubjectLikes(ctx context.Context, c lexutil.LexClient, cursor string, limit int64, uri string) (*FeedGetSubjectLikes_Output, error) {
+//
+// includeSubject: If true, the view of the subject post is returned alongside its likes.
+func FeedGetSubjectLikes(ctx context.Context, c lexutil.LexClient, cursor string, includeSubject bool, limit int64, uri string) (*FeedGetSubjectLikes_Output, error) {
 	var out FeedGetSubjectLikes_Output
 
 	params := map[string]interface{}{}
 	if cursor != "" {
 		params["cursor"] = cursor
 	}
+	if includeSubject {
+		params["includeSubject"] = includeSubject
+	}
 	if limit != 0 {
 		params["limit"] = limit
 	}
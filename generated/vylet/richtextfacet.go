@@ -33,6 +33,7 @@ type RichtextFacet_ByteSlice struct {
 type RichtextFacet_Features_Elem struct {
 	RichtextFacet_Mention *RichtextFacet_Mention
 	RichtextFacet_Link    *RichtextFacet_Link
+	RichtextFacet_Tag     *RichtextFacet_Tag
 }
 
 func (t *RichtextFacet_Features_Elem) MarshalJSON() ([]byte, error) {
@@ -44,6 +45,10 @@ func (t *RichtextFacet_Features_Elem) MarshalJSON() ([]byte, error) {
 		t.RichtextFacet_Link.LexiconTypeID = "app.vylet.richtext.facet#link"
 		return json.Marshal(t.RichtextFacet_Link)
 	}
+	if t.RichtextFacet_Tag != nil {
+		t.RichtextFacet_Tag.LexiconTypeID = "app.vylet.richtext.facet#tag"
+		return json.Marshal(t.RichtextFacet_Tag)
+	}
 	return nil, fmt.Errorf("can not marshal empty union as JSON")
 }
 
@@ -60,6 +65,9 @@ func (t *RichtextFacet_Features_Elem) UnmarshalJSON(b []byte) error {
 	case "app.vylet.richtext.facet#link":
 		t.RichtextFacet_Link = new(RichtextFacet_Link)
 		return json.Unmarshal(b, t.RichtextFacet_Link)
+	case "app.vylet.richtext.facet#tag":
+		t.RichtextFacet_Tag = new(RichtextFacet_Tag)
+		return json.Unmarshal(b, t.RichtextFacet_Tag)
 	default:
 		return nil
 	}
@@ -77,6 +85,9 @@ func (t *RichtextFacet_Features_Elem) MarshalCBOR(w io.Writer) error {
 	if t.RichtextFacet_Link != nil {
 		return t.RichtextFacet_Link.MarshalCBOR(w)
 	}
+	if t.RichtextFacet_Tag != nil {
+		return t.RichtextFacet_Tag.MarshalCBOR(w)
+	}
 	return fmt.Errorf("can not marshal empty union as CBOR")
 }
 
@@ -93,6 +104,9 @@ func (t *RichtextFacet_Features_Elem) UnmarshalCBOR(r io.Reader) error {
 	case "app.vylet.richtext.facet#link":
 		t.RichtextFacet_Link = new(RichtextFacet_Link)
 		return t.RichtextFacet_Link.UnmarshalCBOR(bytes.NewReader(b))
+	case "app.vylet.richtext.facet#tag":
+		t.RichtextFacet_Tag = new(RichtextFacet_Tag)
+		return t.RichtextFacet_Tag.UnmarshalCBOR(bytes.NewReader(b))
 	default:
 		return nil
 	}
@@ -113,3 +127,11 @@ type RichtextFacet_Mention struct {
 	LexiconTypeID string `json:"$type" cborgen:"$type,const=app.vylet.richtext.facet#mention"`
 	Did           string `json:"did" cborgen:"did"`
 }
+
+// RichtextFacet_Tag is a "tag" in the app.vylet.richtext.facet schema.
+//
+// Facet feature for a hashtag. The text usually includes a '#' prefix, but the facet reference should not (except in the case of 'double hash tags').
+type RichtextFacet_Tag struct {
+	LexiconTypeID string `json:"$type" cborgen:"$type,const=app.vylet.richtext.facet#tag"`
+	Tag           string `json:"tag" cborgen:"tag"`
+}
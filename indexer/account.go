@@ -0,0 +1,37 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletkafka "github.com/vylet-app/go/bus/proto"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+// Account events are sent when an account is taken down, suspended, deactivated or deleted, and when it becomes
+// active again. Inactive actors are left out of search.
+func (s *Server) handleAccount(ctx context.Context, evt *vyletkafka.FirehoseEvent) error {
+	var accountEvt comatproto.SyncSubscribeRepos_Account
+	if err := json.Unmarshal(evt.Account, &accountEvt); err != nil {
+		return fmt.Errorf("failed to unmarshal account event: %w", err)
+	}
+
+	did, err := syntax.ParseDID(accountEvt.Did)
+	if err != nil {
+		return fmt.Errorf("failed to parse did: %w", err)
+	}
+
+	if _, err := s.db.Profile.SetActorStatus(ctx, &vyletdatabase.SetActorStatusRequest{
+		Did:    did.String(),
+		Active: accountEvt.Active,
+		Status: accountEvt.Status,
+		Time:   evt.Timestamp,
+	}); err != nil {
+		return fmt.Errorf("failed to create set actor status request: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	vyletkafka "github.com/vylet-app/go/bus/proto"
+	"github.com/vylet-app/go/database/client"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
 	"github.com/vylet-app/go/generated/vylet"
 	"github.com/vylet-app/go/internal/helpers"
@@ -41,19 +42,23 @@ func (s *Server) handleActorProfile(ctx context.Context, evt *vyletkafka.Firehos
 			req.Profile.Avatar = helpers.ToStringPtr(rec.Avatar.Ref.String())
 		}
 
-		resp, err := s.db.Profile.CreateProfile(ctx, &req)
+		_, err = s.db.Profile.CreateProfile(ctx, &req)
 		if err != nil {
 			return fmt.Errorf("failed to create create profile request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error creating profile: %s", *resp.Error)
+
+		if err := s.indexActorForSearch(ctx, req.Profile); err != nil {
+			return fmt.Errorf("failed to index profile for search: %w", err)
 		}
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_UPDATE:
 		if err := json.Unmarshal(op.Record, &rec); err != nil {
 			return fmt.Errorf("failed to unmarshal profile record: %w", err)
 		}
 
-		req := vyletdatabase.CreateProfileRequest{
+		// Updated records replace the whole profile, so no update mask is given. The created at time isn't updated, but
+		// is given so that the actor keeps their typeahead rank.
+		createdAtTime, createdAtErr := time.Parse(time.RFC3339Nano, rec.CreatedAt)
+		req := vyletdatabase.UpdateProfileRequest{
 			Profile: &vyletdatabase.Profile{
 				Did:         evt.Did,
 				DisplayName: rec.DisplayName,
@@ -61,27 +66,41 @@ func (s *Server) handleActorProfile(ctx context.Context, evt *vyletkafka.Firehos
 				Pronouns:    rec.Pronouns,
 			},
 		}
+		if createdAtErr == nil {
+			req.Profile.CreatedAt = timestamppb.New(createdAtTime)
+		}
 
 		if rec.Avatar != nil {
 			req.Profile.Avatar = helpers.ToStringPtr(rec.Avatar.Ref.String())
 		}
 
-		resp, err := s.db.Profile.UpdateProfile(ctx, &req)
-		if err != nil {
+		_, err := s.db.Profile.UpdateProfile(ctx, &req)
+		if client.IsNotFoundError(err) {
+			// The profile's create was never indexed, so the update creates it instead
+			if createdAtErr != nil {
+				return fmt.Errorf("failed to parse time in record: %w", createdAtErr)
+			}
+
+			if _, err := s.db.Profile.CreateProfile(ctx, &vyletdatabase.CreateProfileRequest{Profile: req.Profile}); err != nil {
+				return fmt.Errorf("failed to create create profile request: %w", err)
+			}
+		} else if err != nil {
 			return fmt.Errorf("failed to create update profile request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error updating profile: %w", err)
+
+		if err := s.indexActorForSearch(ctx, req.Profile); err != nil {
+			return fmt.Errorf("failed to index profile for search: %w", err)
 		}
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE:
-		resp, err := s.db.Profile.DeleteProfile(ctx, &vyletdatabase.DeleteProfileRequest{
+		_, err := s.db.Profile.DeleteProfile(ctx, &vyletdatabase.DeleteProfileRequest{
 			Did: evt.Did,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create delete profile request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error deleting profile: %s", *resp.Error)
+
+		if err := s.deleteActorFromSearch(ctx, evt.Did); err != nil {
+			return fmt.Errorf("failed to delete profile from search: %w", err)
 		}
 	}
 
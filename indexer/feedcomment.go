@@ -0,0 +1,58 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vyletkafka "github.com/vylet-app/go/bus/proto"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/vylet"
+	"github.com/vylet-app/go/internal/richtext"
+)
+
+// Comments aren't indexed yet, but the authors of the post and parent comment, along with any mentioned accounts,
+// are notified
+func (s *Server) handleFeedComment(ctx context.Context, evt *vyletkafka.FirehoseEvent) error {
+	var rec vylet.FeedComment
+	op := evt.Commit
+	switch op.Operation {
+	case vyletkafka.CommitOperation_COMMIT_OPERATION_CREATE:
+		if err := json.Unmarshal(op.Record, &rec); err != nil {
+			return fmt.Errorf("failed to unmarshal comment record: %w", err)
+		}
+
+		createdAtTime, err := time.Parse(time.RFC3339Nano, rec.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to parse time from record: %w", err)
+		}
+
+		var pending []pendingNotification
+		if rec.Parent != nil {
+			if notif, ok := notifyRecordAuthor(rec.Parent.Uri, vyletdatabase.NotificationReason_NOTIFICATION_REASON_COMMENT); ok {
+				pending = append(pending, notif)
+			}
+		}
+		if rec.Root != nil {
+			if notif, ok := notifyRecordAuthor(rec.Root.Uri, vyletdatabase.NotificationReason_NOTIFICATION_REASON_COMMENT); ok {
+				pending = append(pending, notif)
+			}
+		}
+
+		facets, _ := richtext.Normalize(ctx, s.directory, rec.Text, rec.Facets)
+		pending = append(pending, notifyMentions(facets)...)
+
+		if err := s.createNotifications(ctx, evt, createdAtTime, pending); err != nil {
+			return fmt.Errorf("failed to create comment notifications: %w", err)
+		}
+	case vyletkafka.CommitOperation_COMMIT_OPERATION_UPDATE:
+		return fmt.Errorf("unsupported comment update event")
+	case vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE:
+		if err := s.deleteNotifications(ctx, evt); err != nil {
+			return fmt.Errorf("failed to delete comment notifications: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -38,24 +38,28 @@ func (s *Server) handleFeedLike(ctx context.Context, evt *vyletkafka.FirehoseEve
 			},
 		}
 
-		resp, err := s.db.Like.CreateLike(ctx, &req)
+		_, err = s.db.Like.CreateLike(ctx, &req)
 		if err != nil {
 			return fmt.Errorf("failed to create create like request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error creating like: %s", *resp.Error)
+
+		if notif, ok := notifyRecordAuthor(rec.Subject.Uri, vyletdatabase.NotificationReason_NOTIFICATION_REASON_LIKE); ok {
+			if err := s.createNotifications(ctx, evt, createdAtTime, []pendingNotification{notif}); err != nil {
+				return fmt.Errorf("failed to create like notifications: %w", err)
+			}
 		}
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_UPDATE:
 		return fmt.Errorf("unsupported like update event")
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE:
-		resp, err := s.db.Like.DeleteLike(ctx, &vyletdatabase.DeleteLikeRequest{
+		_, err := s.db.Like.DeleteLike(ctx, &vyletdatabase.DeleteLikeRequest{
 			Uri: uri,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create delete like request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error deleting like %s", *resp.Error)
+
+		if err := s.deleteNotifications(ctx, evt); err != nil {
+			return fmt.Errorf("failed to delete like notifications: %w", err)
 		}
 	}
 
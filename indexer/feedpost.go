@@ -9,6 +9,7 @@ import (
 	vyletkafka "github.com/vylet-app/go/bus/proto"
 	vyletdatabase "github.com/vylet-app/go/database/proto"
 	"github.com/vylet-app/go/generated/vylet"
+	"github.com/vylet-app/go/internal/richtext"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -46,6 +47,19 @@ func (s *Server) handleFeedPost(ctx context.Context, evt *vyletkafka.FirehoseEve
 			images = append(images, dbimg)
 		}
 
+		var caption string
+		if rec.Caption != nil {
+			caption = *rec.Caption
+		}
+
+		facets, dropped := richtext.Normalize(ctx, s.directory, caption, rec.Facets)
+		for _, reason := range dropped {
+			facetsDropped.WithLabelValues(reason).Inc()
+		}
+		if len(dropped) > 0 {
+			s.logger.Debug("dropped invalid facets from post", "uri", uri, "reasons", dropped)
+		}
+
 		req := vyletdatabase.CreatePostRequest{
 			Post: &vyletdatabase.Post{
 				Uri:       uri,
@@ -53,36 +67,40 @@ func (s *Server) handleFeedPost(ctx context.Context, evt *vyletkafka.FirehoseEve
 				AuthorDid: evt.Did,
 				Images:    images,
 				Caption:   rec.Caption,
+				Facets:    facets,
+				Tags:      richtext.ExtractTags(caption, facets),
 				CreatedAt: timestamppb.New(createdAtTime),
 			},
 		}
 
-		if rec.Facets != nil {
-			b, err := json.Marshal(rec.Facets)
-			if err != nil {
-				return fmt.Errorf("failed to marshal facets: %w", err)
-			}
-			req.Post.Facets = b
-		}
-
-		resp, err := s.db.Post.CreatePost(ctx, &req)
+		_, err = s.db.Post.CreatePost(ctx, &req)
 		if err != nil {
 			return fmt.Errorf("failed to create create post request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error creating post: %s", *resp.Error)
+
+		if err := s.indexPostForSearch(ctx, req.Post); err != nil {
+			return fmt.Errorf("failed to index post for search: %w", err)
+		}
+
+		if err := s.createNotifications(ctx, evt, createdAtTime, notifyMentions(facets)); err != nil {
+			return fmt.Errorf("failed to create post notifications: %w", err)
 		}
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_UPDATE:
 		return fmt.Errorf("unsupported post update event")
 	case vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE:
-		resp, err := s.db.Post.DeletePost(ctx, &vyletdatabase.DeletePostRequest{
+		_, err := s.db.Post.DeletePost(ctx, &vyletdatabase.DeletePostRequest{
 			Uri: uri,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create delete post request: %w", err)
 		}
-		if resp.Error != nil {
-			return fmt.Errorf("error deleting post %s", *resp.Error)
+
+		if err := s.deletePostFromSearch(ctx, uri); err != nil {
+			return fmt.Errorf("failed to delete post from search: %w", err)
+		}
+
+		if err := s.deleteNotifications(ctx, evt); err != nil {
+			return fmt.Errorf("failed to delete post notifications: %w", err)
 		}
 	}
 
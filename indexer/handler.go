@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"slices"
 
 	vyletkafka "github.com/vylet-app/go/bus/proto"
 )
@@ -11,10 +12,25 @@ func (s *Server) handleEvent(ctx context.Context, evt *vyletkafka.FirehoseEvent)
 		return s.handleCommit(ctx, evt)
 	}
 
+	if evt.Identity != nil {
+		return s.handleIdentity(ctx, evt)
+	}
+
+	if evt.Account != nil {
+		return s.handleAccount(ctx, evt)
+	}
+
 	return nil
 }
 
 func (s *Server) handleCommit(ctx context.Context, evt *vyletkafka.FirehoseEvent) error {
+	isWrite := evt.Commit.Operation != vyletkafka.CommitOperation_COMMIT_OPERATION_DELETE
+	if s.validator != nil && isWrite && slices.Contains(indexedCollections, evt.Commit.Collection) {
+		if indexRecord := s.validateRecord(ctx, evt); !indexRecord {
+			return nil
+		}
+	}
+
 	switch evt.Commit.Collection {
 	case "app.vylet.actor.profile":
 		return s.handleActorProfile(ctx, evt)
@@ -22,7 +38,46 @@ func (s *Server) handleCommit(ctx context.Context, evt *vyletkafka.FirehoseEvent
 		return s.handleFeedPost(ctx, evt)
 	case "app.vylet.feed.like":
 		return s.handleFeedLike(ctx, evt)
+	case "app.vylet.feed.comment":
+		return s.handleFeedComment(ctx, evt)
+	case "app.vylet.graph.follow":
+		return s.handleGraphFollow(ctx, evt)
 	}
 
 	return nil
 }
+
+// Validates the record in a create or update event, taking the configured action if it is invalid. Returns
+// whether the record should be indexed.
+func (s *Server) validateRecord(ctx context.Context, evt *vyletkafka.FirehoseEvent) bool {
+	collection := evt.Commit.Collection
+
+	violation := s.validator.validate(collection, evt.Commit.Record)
+	if violation == nil {
+		recordsValidated.WithLabelValues(collection, "valid").Inc()
+		return true
+	}
+
+	recordsValidated.WithLabelValues(collection, "invalid").Inc()
+	recordViolations.WithLabelValues(collection, violation.Type).Inc()
+
+	logger := s.logger.With("name", "validateRecord", "uri", firehoseEventToUri(evt), "cid", evt.Commit.Cid, "action", s.invalidRecordAction)
+	logger.Warn("received invalid record", "violation", violation.Type, "err", violation.Err)
+
+	switch s.invalidRecordAction {
+	case InvalidRecordActionAllow:
+		invalidRecordActions.WithLabelValues(collection, s.invalidRecordAction, "ok").Inc()
+		return true
+	case InvalidRecordActionQuarantine:
+		status := "ok"
+		if err := s.quarantineProducer.ProduceSync(ctx, evt.Did, evt); err != nil {
+			logger.Error("failed to quarantine invalid record", "err", err)
+			status = "error"
+		}
+		invalidRecordActions.WithLabelValues(collection, s.invalidRecordAction, status).Inc()
+	default:
+		invalidRecordActions.WithLabelValues(collection, s.invalidRecordAction, "ok").Inc()
+	}
+
+	return false
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	comatproto "github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletkafka "github.com/vylet-app/go/bus/proto"
+	"github.com/vylet-app/go/database/client"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+// Identity events are sent when an account's handle or DID document changes. Actors with a profile are reindexed
+// for search so that they can be found by their new handle.
+func (s *Server) handleIdentity(ctx context.Context, evt *vyletkafka.FirehoseEvent) error {
+	var identityEvt comatproto.SyncSubscribeRepos_Identity
+	if err := json.Unmarshal(evt.Identity, &identityEvt); err != nil {
+		return fmt.Errorf("failed to unmarshal identity event: %w", err)
+	}
+
+	did, err := syntax.ParseDID(identityEvt.Did)
+	if err != nil {
+		return fmt.Errorf("failed to parse did: %w", err)
+	}
+
+	if err := s.directory.Purge(ctx, did.AtIdentifier()); err != nil {
+		s.logger.Warn("failed to purge identity from directory", "did", did, "err", err)
+	}
+
+	resp, err := s.db.Profile.GetProfile(ctx, &vyletdatabase.GetProfileRequest{
+		Did: did.String(),
+	})
+	if client.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create get profile request: %w", err)
+	}
+
+	if err := s.indexActorForSearch(ctx, resp.Profile); err != nil {
+		return fmt.Errorf("failed to index actor for search: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "indexer"
+)
+
+var (
+	// Records checked against their lexicon, by collection and result (valid/invalid)
+	recordsValidated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "records_validated_total",
+		Help:      "Total number of records validated against their lexicon",
+	}, []string{"collection", "status"})
+
+	// Invalid records by collection and the type of violation that was found
+	recordViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "record_violations_total",
+		Help:      "Total number of invalid records by violation type",
+	}, []string{"collection", "violation"})
+
+	// Invalid records by collection and the action that was taken for them
+	invalidRecordActions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "invalid_record_actions_total",
+		Help:      "Total number of invalid records by the action taken",
+	}, []string{"collection", "action", "status"})
+
+	// Post facets, or individual facet features, dropped during normalization by the reason they were dropped
+	facetsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "facets_dropped_total",
+		Help:      "Total number of post facets or facet features dropped during normalization",
+	}, []string{"reason"})
+
+	// Notifications created from indexed records, by reason
+	notificationsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notifications_created_total",
+		Help:      "Total number of notifications created by reason",
+	}, []string{"reason"})
+)
@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletkafka "github.com/vylet-app/go/bus/proto"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// A notification to be created for the record in a firehose event
+type pendingNotification struct {
+	recipient     string
+	reason        vyletdatabase.NotificationReason
+	reasonSubject *string
+}
+
+// Creates notifications for the record in a create event. Notifications to the record's own author are skipped,
+// as are additional notifications to a recipient that has already been notified, so the first reason given for
+// a recipient takes precedence.
+func (s *Server) createNotifications(ctx context.Context, evt *vyletkafka.FirehoseEvent, createdAt time.Time, pending []pendingNotification) error {
+	uri := firehoseEventToUri(evt)
+
+	notified := make(map[string]struct{})
+	var notifications []*vyletdatabase.Notification
+	for _, p := range pending {
+		if p.recipient == evt.Did {
+			continue
+		}
+		if _, ok := notified[p.recipient]; ok {
+			continue
+		}
+		notified[p.recipient] = struct{}{}
+
+		notifications = append(notifications, &vyletdatabase.Notification{
+			RecipientDid:  p.recipient,
+			Uri:           uri,
+			Cid:           evt.Commit.Cid,
+			AuthorDid:     evt.Did,
+			Reason:        p.reason,
+			ReasonSubject: p.reasonSubject,
+			CreatedAt:     timestamppb.New(createdAt),
+		})
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	_, err := s.db.Notification.CreateNotifications(ctx, &vyletdatabase.CreateNotificationsRequest{
+		Notifications: notifications,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create create notifications request: %w", err)
+	}
+
+	for _, notif := range notifications {
+		notificationsCreated.WithLabelValues(notificationReasonLabel(notif.Reason)).Inc()
+	}
+
+	return nil
+}
+
+// Deletes the notifications caused by the record in a delete event
+func (s *Server) deleteNotifications(ctx context.Context, evt *vyletkafka.FirehoseEvent) error {
+	_, err := s.db.Notification.DeleteNotificationsByUri(ctx, &vyletdatabase.DeleteNotificationsByUriRequest{
+		Uri: firehoseEventToUri(evt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create delete notifications request: %w", err)
+	}
+
+	return nil
+}
+
+// Returns a notification to the author of the record at the given URI
+func notifyRecordAuthor(uri string, reason vyletdatabase.NotificationReason) (pendingNotification, bool) {
+	aturi, err := syntax.ParseATURI(uri)
+	if err != nil {
+		return pendingNotification{}, false
+	}
+
+	did, err := aturi.Authority().AsDID()
+	if err != nil {
+		return pendingNotification{}, false
+	}
+
+	return pendingNotification{
+		recipient:     did.String(),
+		reason:        reason,
+		reasonSubject: &uri,
+	}, true
+}
+
+// Returns notifications for each account mentioned in normalized facets
+func notifyMentions(facets []*vyletdatabase.Facet) []pendingNotification {
+	var pending []pendingNotification
+	for _, facet := range facets {
+		for _, feature := range facet.Features {
+			if mention, ok := feature.Feature.(*vyletdatabase.FacetFeature_Mention); ok {
+				pending = append(pending, pendingNotification{
+					recipient: mention.Mention,
+					reason:    vyletdatabase.NotificationReason_NOTIFICATION_REASON_MENTION,
+				})
+			}
+		}
+	}
+	return pending
+}
+
+func notificationReasonLabel(reason vyletdatabase.NotificationReason) string {
+	switch reason {
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_LIKE:
+		return "like"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_FOLLOW:
+		return "follow"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_COMMENT:
+		return "comment"
+	case vyletdatabase.NotificationReason_NOTIFICATION_REASON_MENTION:
+		return "mention"
+	}
+	return "unknown"
+}
@@ -0,0 +1,96 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Adds a post to the search index, including the text of its images' alt text and its tags
+func (s *Server) indexPostForSearch(ctx context.Context, post *vyletdatabase.Post) error {
+	req := vyletdatabase.IndexPostRequest{
+		Uri:       post.Uri,
+		CreatedAt: post.CreatedAt,
+		Caption:   post.Caption,
+		Tags:      post.Tags,
+	}
+	for _, img := range post.Images {
+		req.ImageCids = append(req.ImageCids, img.Cid)
+		if img.Alt != nil && *img.Alt != "" {
+			req.AltTexts = append(req.AltTexts, *img.Alt)
+		}
+	}
+
+	_, err := s.db.Search.IndexPost(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("failed to create index post request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) deletePostFromSearch(ctx context.Context, uri string) error {
+	_, err := s.db.Search.DeletePostFromIndex(ctx, &vyletdatabase.DeletePostFromIndexRequest{
+		Uri: uri,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create delete post from index request: %w", err)
+	}
+
+	return nil
+}
+
+// Adds an actor to the search index. Their handle is resolved from their DID, and if that fails they are indexed
+// without it.
+func (s *Server) indexActorForSearch(ctx context.Context, profile *vyletdatabase.Profile) error {
+	req := vyletdatabase.IndexActorRequest{
+		Did:         profile.Did,
+		DisplayName: profile.DisplayName,
+		Description: profile.Description,
+		RankedAt:    profile.CreatedAt,
+	}
+
+	// Actors are ranked by when their profile was created, but a profile can't claim to be newer than when it was
+	// indexed, or than now if that isn't known
+	latest := timestamppb.Now()
+	if profile.IndexedAt != nil {
+		latest = profile.IndexedAt
+	}
+	if req.RankedAt != nil && req.RankedAt.AsTime().After(latest.AsTime()) {
+		req.RankedAt = latest
+	}
+
+	did, err := syntax.ParseDID(profile.Did)
+	if err != nil {
+		return fmt.Errorf("failed to parse did: %w", err)
+	}
+
+	ident, err := s.directory.LookupDID(ctx, did)
+	if err != nil {
+		s.logger.Warn("failed to resolve handle for search index", "did", profile.Did, "err", err)
+	} else if !ident.Handle.IsInvalidHandle() {
+		handle := ident.Handle.String()
+		req.Handle = &handle
+	}
+
+	_, err = s.db.Search.IndexActor(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("failed to create index actor request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) deleteActorFromSearch(ctx context.Context, did string) error {
+	_, err := s.db.Search.DeleteActorFromIndex(ctx, &vyletdatabase.DeleteActorFromIndexRequest{
+		Did: did,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create delete actor from index request: %w", err)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/bluesky-social/go-util/pkg/bus/consumer"
+	"github.com/bluesky-social/go-util/pkg/bus/producer"
+	"github.com/bluesky-social/indigo/atproto/identity"
 	vyletkafka "github.com/vylet-app/go/bus/proto"
 	"github.com/vylet-app/go/database/client"
 )
@@ -19,6 +21,13 @@ type Server struct {
 
 	consumer *consumer.Consumer[*vyletkafka.FirehoseEvent]
 	db       *client.Client
+
+	// Used to resolve the DIDs of mentions in post facets
+	directory identity.Directory
+
+	validator           *recordValidator
+	invalidRecordAction string
+	quarantineProducer  *producer.Producer[*vyletkafka.FirehoseEvent]
 }
 
 type Args struct {
@@ -28,10 +37,18 @@ type Args struct {
 	InputTopic       string
 	ConsumerGroup    string
 
-	DatabaseHost string
+	DatabaseHost    string
+	DatabaseOptions client.Options
+
+	// Path to the lexicons that incoming records are validated against. If empty, records are not validated.
+	LexiconsPath string
+	// One of the InvalidRecordAction constants. Defaults to InvalidRecordActionReject.
+	InvalidRecordAction string
+	// Topic that invalid records are produced to when using InvalidRecordActionQuarantine
+	QuarantineTopic string
 }
 
-func New(args *Args) (*Server, error) {
+func New(ctx context.Context, args *Args) (*Server, error) {
 	if args.Logger == nil {
 		args.Logger = slog.Default()
 	}
@@ -39,7 +56,8 @@ func New(args *Args) (*Server, error) {
 	logger := args.Logger
 
 	db, err := client.New(&client.Args{
-		Addr: args.DatabaseHost,
+		Addr:    args.DatabaseHost,
+		Options: args.DatabaseOptions,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a new database client: %w", err)
@@ -49,6 +67,45 @@ func New(args *Args) (*Server, error) {
 		logger: logger,
 
 		db: db,
+
+		directory: identity.DefaultDirectory(),
+	}
+
+	if args.LexiconsPath != "" {
+		validator, err := newRecordValidator(args.LexiconsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create record validator: %w", err)
+		}
+		server.validator = validator
+	} else {
+		logger.Warn("no lexicons path provided, records will not be validated")
+	}
+
+	switch args.InvalidRecordAction {
+	case "":
+		server.invalidRecordAction = InvalidRecordActionReject
+	case InvalidRecordActionReject, InvalidRecordActionAllow:
+		server.invalidRecordAction = args.InvalidRecordAction
+	case InvalidRecordActionQuarantine:
+		if args.QuarantineTopic == "" {
+			return nil, fmt.Errorf("a quarantine topic is required when quarantining invalid records")
+		}
+
+		quarantineProducer, err := producer.New(
+			ctx,
+			logger.With("component", "quarantine-producer"),
+			args.BootstrapServers,
+			args.QuarantineTopic,
+			producer.WithEnsureTopic[*vyletkafka.FirehoseEvent](true),
+			producer.WithRetentionTime[*vyletkafka.FirehoseEvent](7*24*time.Hour),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create quarantine producer: %w", err)
+		}
+		server.quarantineProducer = quarantineProducer
+		server.invalidRecordAction = args.InvalidRecordAction
+	default:
+		return nil, fmt.Errorf("unknown invalid record action %q", args.InvalidRecordAction)
 	}
 
 	busConsumer, err := consumer.New(
@@ -110,6 +167,10 @@ func (s *Server) Run(ctx context.Context) error {
 
 	s.consumer.Close()
 
+	if s.quarantineProducer != nil {
+		s.quarantineProducer.Close()
+	}
+
 	if err := s.db.Close(); err != nil {
 		logger.Error("failed to close database client", "err", err)
 	}
@@ -0,0 +1,120 @@
+package indexer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/atdata"
+	"github.com/bluesky-social/indigo/atproto/lexicon"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+)
+
+// Actions that may be taken for records which fail validation
+const (
+	// Invalid records are dropped
+	InvalidRecordActionReject = "reject"
+	// Invalid records are dropped and the event is produced to the quarantine topic for later inspection
+	InvalidRecordActionQuarantine = "quarantine"
+	// Invalid records are counted and logged, but still indexed
+	InvalidRecordActionAllow = "allow"
+)
+
+// Collections handled by the indexer, all of which must have a lexicon available for validation
+var indexedCollections = []string{
+	"app.vylet.actor.profile",
+	"app.vylet.feed.post",
+	"app.vylet.feed.like",
+	"app.vylet.feed.comment",
+	"app.vylet.graph.follow",
+}
+
+// How far in the future a record's createdAt may be, to allow for clock skew between clients and the indexer
+const maxCreatedAtSkew = 10 * time.Minute
+
+// Maps the errors returned by lexicon validation to the violation types reported in metrics. Errors that don't
+// match any of these come from string format parsing.
+var violationTypes = []struct {
+	substr    string
+	violation string
+}{
+	{"required field missing", "missing_field"},
+	{"string length", "string_length"},
+	{"array length", "array_length"},
+	{"bytes size", "bytes_length"},
+	{"blob mimetype", "blob_mime_type"},
+	{"blob size", "blob_size"},
+	{"legacy blobs", "legacy_blob"},
+	{"integer val outside", "integer_range"},
+	{"enum", "enum"},
+	{"constant", "const"},
+	{"union", "union"},
+	{"$type", "type_mismatch"},
+	{"expected a", "wrong_type"},
+}
+
+type recordViolation struct {
+	Type string
+	Err  error
+}
+
+func (v *recordViolation) Error() string {
+	return fmt.Sprintf("%s: %v", v.Type, v.Err)
+}
+
+type recordValidator struct {
+	catalog *lexicon.BaseCatalog
+}
+
+func newRecordValidator(lexiconsPath string) (*recordValidator, error) {
+	catalog := lexicon.NewBaseCatalog()
+	if err := catalog.LoadDirectory(lexiconsPath); err != nil {
+		return nil, fmt.Errorf("failed to load lexicons: %w", err)
+	}
+
+	for _, collection := range indexedCollections {
+		if _, err := catalog.Resolve(collection); err != nil {
+			return nil, fmt.Errorf("failed to resolve lexicon for %s: %w", collection, err)
+		}
+	}
+
+	return &recordValidator{
+		catalog: &catalog,
+	}, nil
+}
+
+// Validates a record from the firehose against the lexicon for its collection, along with checks that the
+// lexicon can't express. Returns nil if the record is valid.
+func (v *recordValidator) validate(collection string, raw []byte) *recordViolation {
+	data, err := atdata.UnmarshalJSON(raw)
+	if err != nil {
+		return &recordViolation{Type: "malformed", Err: err}
+	}
+
+	if err := lexicon.ValidateRecord(v.catalog, data, collection, 0); err != nil {
+		return &recordViolation{Type: violationType(err), Err: err}
+	}
+
+	if createdAt, ok := data["createdAt"].(string); ok {
+		// The format has already been checked against the lexicon
+		dt, err := syntax.ParseDatetime(createdAt)
+		if err != nil {
+			return &recordViolation{Type: "invalid_format", Err: err}
+		}
+		if dt.Time().After(time.Now().Add(maxCreatedAtSkew)) {
+			return &recordViolation{Type: "created_at_in_future", Err: fmt.Errorf("createdAt is in the future: %s", createdAt)}
+		}
+	}
+
+	return nil
+}
+
+func violationType(err error) string {
+	msg := err.Error()
+	for _, vt := range violationTypes {
+		if strings.Contains(msg, vt.substr) {
+			return vt.violation
+		}
+	}
+	return "invalid_format"
+}
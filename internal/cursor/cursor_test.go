@@ -0,0 +1,74 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+
+	tests := []struct {
+		name string
+		cur  Cursor
+	}{
+		{name: "time and key", cur: Cursor{Time: time.Date(2025, 1, 2, 3, 4, 5, 6_000_000, time.UTC), Key: "at://did:plc:abc/app.vylet.feed.post/3k"}},
+		{name: "key only", cur: Cursor{Key: "did:plc:abc"}},
+		{name: "time only", cur: Cursor{Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)}},
+		{name: "empty", cur: Cursor{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := codec.Decode("posts_by_actor/did:plc:abc", codec.Encode("posts_by_actor/did:plc:abc", tt.cur))
+			if err != nil {
+				t.Fatalf("failed to decode: %v", err)
+			}
+			if !decoded.Time.Equal(tt.cur.Time) || decoded.Key != tt.cur.Key {
+				t.Fatalf("got %+v, want %+v", decoded, tt.cur)
+			}
+		})
+	}
+}
+
+func TestDecodeRejectsInvalidCursors(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	scope := "likes_by_subject/at://did:plc:abc/app.vylet.feed.post/3k"
+	encoded := codec.Encode(scope, Cursor{Time: time.Now(), Key: "at://did:plc:def/app.vylet.feed.like/3k"})
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("failed to decode base64: %v", err)
+	}
+	reencode := func(modify func(raw []byte) []byte) string {
+		return base64.RawURLEncoding.EncodeToString(modify(append([]byte(nil), raw...)))
+	}
+
+	tests := []struct {
+		name    string
+		codec   *Codec
+		scope   string
+		encoded string
+	}{
+		{name: "not base64", codec: codec, scope: scope, encoded: "not a cursor!"},
+		{name: "empty", codec: codec, scope: scope, encoded: ""},
+		{name: "truncated", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { return raw[:len(raw)-1] })},
+		{name: "too short", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { return raw[:1+timeLength] })},
+		{name: "tampered time", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { raw[1+timeLength-1] ^= 1; return raw })},
+		{name: "tampered key", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { raw[1+timeLength] ^= 1; return raw })},
+		{name: "tampered signature", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { raw[len(raw)-1] ^= 1; return raw })},
+		{name: "unknown version", codec: codec, scope: scope, encoded: reencode(func(raw []byte) []byte { raw[0] = version1 + 1; return raw })},
+		{name: "other scope", codec: codec, scope: "likes_by_subject/at://did:plc:abc/app.vylet.feed.post/3l", encoded: encoded},
+		{name: "other secret", codec: NewCodec([]byte("other secret")), scope: scope, encoded: encoded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.codec.Decode(tt.scope, tt.encoded); !errors.Is(err, ErrInvalidCursor) {
+				t.Fatalf("got err %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package helpers
 import (
 	"fmt"
 
-	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/bluesky-social/indigo/lex/util"
 	"github.com/ipfs/go-cid"
 	"github.com/multiformats/go-multihash"
@@ -17,10 +16,6 @@ func ToIntPtr(num int) *int {
 	return &num
 }
 
-func ToInt64Ptr(num int64) *int64 {
-	return &num
-}
-
 func ImageCidToCdnUrl(cid string, size string) string {
 	return fmt.Sprintf("https://cdn.vylet.app/%s/%s@png", cid, size)
 }
@@ -37,12 +32,3 @@ func StrToLexLink(str string) util.LexLink {
 	cid := StrToCid(str)
 	return (util.LexLink)(cid)
 }
-
-func ValidateUris(uris []string) (bool, error) {
-	for _, uri := range uris {
-		if _, err := syntax.ParseATURI(uri); err != nil {
-			return false, err
-		}
-	}
-	return true, nil
-}
@@ -0,0 +1,222 @@
+package richtext
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bluesky-social/indigo/atproto/identity"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+// Reasons that a facet, or one of its features, was dropped during normalization
+const (
+	DropOutOfBounds       = "out_of_bounds"
+	DropNotCharBoundary   = "not_char_boundary"
+	DropOverlapping       = "overlapping"
+	DropNoFeatures        = "no_features"
+	DropInvalidMention    = "invalid_mention"
+	DropUnresolvedMention = "unresolved_mention"
+	DropInvalidLink       = "invalid_link"
+	DropInvalidTag        = "invalid_tag"
+)
+
+// Maximum length of a tag in bytes. The lexicon's grapheme limit is checked by lexicon validation.
+const maxTagLength = 640
+
+// Validates facets against the UTF-8 bytes of the text they annotate and normalizes their features. Facets that
+// can't be applied to the text are dropped, as are features that fail validation, so the result is always safe
+// to render. Mentions are resolved with the given directory, if one is provided; mentions of DIDs that don't
+// exist are dropped, while other resolution failures are ignored. Returns the normalized facets along with the
+// reason for each facet or feature that was dropped.
+func Normalize(ctx context.Context, dir identity.Directory, text string, facets []*vylet.RichtextFacet) ([]*vyletdatabase.Facet, []string) {
+	var dropped []string
+
+	candidates := make([]*vylet.RichtextFacet, 0, len(facets))
+	for _, facet := range facets {
+		if facet == nil || facet.Index == nil {
+			dropped = append(dropped, DropOutOfBounds)
+			continue
+		}
+
+		start, end := facet.Index.ByteStart, facet.Index.ByteEnd
+		if start < 0 || end > int64(len(text)) || start >= end {
+			dropped = append(dropped, DropOutOfBounds)
+			continue
+		}
+
+		if !isCharBoundary(text, start) || !isCharBoundary(text, end) {
+			dropped = append(dropped, DropNotCharBoundary)
+			continue
+		}
+
+		candidates = append(candidates, facet)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Index.ByteStart < candidates[j].Index.ByteStart
+	})
+
+	normalized := make([]*vyletdatabase.Facet, 0, len(candidates))
+	var lastEnd int64
+	for _, facet := range candidates {
+		if len(normalized) > 0 && facet.Index.ByteStart < lastEnd {
+			dropped = append(dropped, DropOverlapping)
+			continue
+		}
+
+		var features []*vyletdatabase.FacetFeature
+		for _, feature := range facet.Features {
+			f, reason := normalizeFeature(ctx, dir, feature)
+			if reason != "" {
+				dropped = append(dropped, reason)
+				continue
+			}
+			if f != nil {
+				features = append(features, f)
+			}
+		}
+
+		if len(features) == 0 {
+			dropped = append(dropped, DropNoFeatures)
+			continue
+		}
+
+		normalized = append(normalized, &vyletdatabase.Facet{
+			ByteStart: facet.Index.ByteStart,
+			ByteEnd:   facet.Index.ByteEnd,
+			Features:  features,
+		})
+		lastEnd = facet.Index.ByteEnd
+	}
+
+	return normalized, dropped
+}
+
+// Returns the normalized feature, or the reason it was dropped. Features of unknown types are ignored without
+// a reason, since newer clients may use features that we don't know about yet.
+func normalizeFeature(ctx context.Context, dir identity.Directory, feature *vylet.RichtextFacet_Features_Elem) (*vyletdatabase.FacetFeature, string) {
+	switch {
+	case feature == nil:
+		return nil, ""
+	case feature.RichtextFacet_Mention != nil:
+		did, err := syntax.ParseDID(feature.RichtextFacet_Mention.Did)
+		if err != nil {
+			return nil, DropInvalidMention
+		}
+		if dir != nil {
+			if _, err := dir.LookupDID(ctx, did); errors.Is(err, identity.ErrDIDNotFound) {
+				return nil, DropUnresolvedMention
+			}
+		}
+		return &vyletdatabase.FacetFeature{
+			Feature: &vyletdatabase.FacetFeature_Mention{Mention: did.String()},
+		}, ""
+	case feature.RichtextFacet_Link != nil:
+		link, ok := NormalizeLink(feature.RichtextFacet_Link.Uri)
+		if !ok {
+			return nil, DropInvalidLink
+		}
+		return &vyletdatabase.FacetFeature{
+			Feature: &vyletdatabase.FacetFeature_Link{Link: link},
+		}, ""
+	case feature.RichtextFacet_Tag != nil:
+		tag, ok := NormalizeTag(feature.RichtextFacet_Tag.Tag)
+		if !ok {
+			return nil, DropInvalidTag
+		}
+		return &vyletdatabase.FacetFeature{
+			Feature: &vyletdatabase.FacetFeature_Tag{Tag: tag},
+		}, ""
+	}
+
+	return nil, ""
+}
+
+func isCharBoundary(text string, idx int64) bool {
+	return idx == int64(len(text)) || utf8.RuneStart(text[idx])
+}
+
+// Normalizes a link to an absolute http(s) URL with a lowercase scheme and host.
+func NormalizeLink(raw string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", false
+	}
+
+	u.Scheme = scheme
+	u.Host = strings.ToLower(u.Host)
+
+	return u.String(), true
+}
+
+// Normalizes a tag by removing its leading '#' and lowercasing it.
+func NormalizeTag(raw string) (string, bool) {
+	tag := strings.TrimSpace(raw)
+	tag = strings.TrimPrefix(tag, "#")
+	tag = strings.TrimPrefix(tag, "＃")
+
+	if tag == "" || len(tag) > maxTagLength || strings.ContainsFunc(tag, unicode.IsSpace) {
+		return "", false
+	}
+
+	return strings.ToLower(tag), true
+}
+
+// Converts stored facets back to their lexicon form.
+func ToLexicon(facets []*vyletdatabase.Facet) []*vylet.RichtextFacet {
+	out := make([]*vylet.RichtextFacet, 0, len(facets))
+	for _, facet := range facets {
+		features := make([]*vylet.RichtextFacet_Features_Elem, 0, len(facet.Features))
+		for _, feature := range facet.Features {
+			switch f := feature.Feature.(type) {
+			case *vyletdatabase.FacetFeature_Mention:
+				features = append(features, &vylet.RichtextFacet_Features_Elem{
+					RichtextFacet_Mention: &vylet.RichtextFacet_Mention{
+						LexiconTypeID: "app.vylet.richtext.facet#mention",
+						Did:           f.Mention,
+					},
+				})
+			case *vyletdatabase.FacetFeature_Link:
+				features = append(features, &vylet.RichtextFacet_Features_Elem{
+					RichtextFacet_Link: &vylet.RichtextFacet_Link{
+						LexiconTypeID: "app.vylet.richtext.facet#link",
+						Uri:           f.Link,
+					},
+				})
+			case *vyletdatabase.FacetFeature_Tag:
+				features = append(features, &vylet.RichtextFacet_Features_Elem{
+					RichtextFacet_Tag: &vylet.RichtextFacet_Tag{
+						LexiconTypeID: "app.vylet.richtext.facet#tag",
+						Tag:           f.Tag,
+					},
+				})
+			}
+		}
+
+		if len(features) == 0 {
+			continue
+		}
+
+		out = append(out, &vylet.RichtextFacet{
+			Index: &vylet.RichtextFacet_ByteSlice{
+				ByteStart: facet.ByteStart,
+				ByteEnd:   facet.ByteEnd,
+			},
+			Features: features,
+		})
+	}
+
+	return out
+}
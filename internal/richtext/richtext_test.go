@@ -0,0 +1,178 @@
+package richtext
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/bluesky-social/indigo/atproto/identity"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+	"github.com/vylet-app/go/generated/vylet"
+)
+
+func facet(start, end int64, features ...*vylet.RichtextFacet_Features_Elem) *vylet.RichtextFacet {
+	return &vylet.RichtextFacet{
+		Index:    &vylet.RichtextFacet_ByteSlice{ByteStart: start, ByteEnd: end},
+		Features: features,
+	}
+}
+
+func mention(did string) *vylet.RichtextFacet_Features_Elem {
+	return &vylet.RichtextFacet_Features_Elem{RichtextFacet_Mention: &vylet.RichtextFacet_Mention{Did: did}}
+}
+
+func link(uri string) *vylet.RichtextFacet_Features_Elem {
+	return &vylet.RichtextFacet_Features_Elem{RichtextFacet_Link: &vylet.RichtextFacet_Link{Uri: uri}}
+}
+
+func tag(tag string) *vylet.RichtextFacet_Features_Elem {
+	return &vylet.RichtextFacet_Features_Elem{RichtextFacet_Tag: &vylet.RichtextFacet_Tag{Tag: tag}}
+}
+
+// Formats normalized facets as "start-end:kind=value" so they can be compared
+func describe(facets []*vyletdatabase.Facet) []string {
+	var out []string
+	for _, f := range facets {
+		for _, feature := range f.Features {
+			var desc string
+			switch v := feature.Feature.(type) {
+			case *vyletdatabase.FacetFeature_Mention:
+				desc = "mention=" + v.Mention
+			case *vyletdatabase.FacetFeature_Link:
+				desc = "link=" + v.Link
+			case *vyletdatabase.FacetFeature_Tag:
+				desc = "tag=" + v.Tag
+			}
+			out = append(out, fmt.Sprintf("%d-%d:%s", f.ByteStart, f.ByteEnd, desc))
+		}
+	}
+	return out
+}
+
+func TestNormalize(t *testing.T) {
+	dir := identity.NewMockDirectory()
+	dir.Insert(identity.Identity{DID: syntax.DID("did:plc:known"), Handle: syntax.Handle("known.test")})
+
+	tests := []struct {
+		name        string
+		text        string
+		facets      []*vylet.RichtextFacet
+		want        []string
+		wantDropped []string
+	}{
+		{
+			name:   "valid features are normalized",
+			text:   "abcdefgh",
+			facets: []*vylet.RichtextFacet{facet(0, 2, tag("#Go")), facet(2, 4, link("HTTPS://Example.COM/Path")), facet(4, 6, mention("did:plc:known"))},
+			want:   []string{"0-2:tag=go", "2-4:link=https://example.com/Path", "4-6:mention=did:plc:known"},
+		},
+		{
+			name:        "out of bounds",
+			text:        "abc",
+			facets:      []*vylet.RichtextFacet{facet(0, 4, tag("a")), facet(2, 2, tag("b")), facet(-1, 1, tag("c")), {Features: []*vylet.RichtextFacet_Features_Elem{tag("d")}}, nil},
+			wantDropped: []string{DropOutOfBounds, DropOutOfBounds, DropOutOfBounds, DropOutOfBounds, DropOutOfBounds},
+		},
+		{
+			name:        "not a character boundary",
+			text:        "é!",
+			facets:      []*vylet.RichtextFacet{facet(1, 3, tag("a")), facet(0, 2, tag("b"))},
+			want:        []string{"0-2:tag=b"},
+			wantDropped: []string{DropNotCharBoundary},
+		},
+		{
+			name:        "overlapping facets keep the earliest",
+			text:        "abcdef",
+			facets:      []*vylet.RichtextFacet{facet(2, 5, tag("later")), facet(0, 3, tag("first")), facet(5, 6, tag("last"))},
+			want:        []string{"0-3:tag=first", "5-6:tag=last"},
+			wantDropped: []string{DropOverlapping},
+		},
+		{
+			name:        "invalid features",
+			text:        "abcdefgh",
+			facets:      []*vylet.RichtextFacet{facet(0, 2, mention("not a did")), facet(2, 4, link("ftp://example.com")), facet(4, 6, tag("two words")), facet(6, 8, mention("did:plc:unknown"))},
+			wantDropped: []string{DropInvalidMention, DropNoFeatures, DropInvalidLink, DropNoFeatures, DropInvalidTag, DropNoFeatures, DropUnresolvedMention, DropNoFeatures},
+		},
+		{
+			name:        "invalid features are dropped from facets that keep others",
+			text:        "ab",
+			facets:      []*vylet.RichtextFacet{facet(0, 2, tag(""), tag("ok"))},
+			want:        []string{"0-2:tag=ok"},
+			wantDropped: []string{DropInvalidTag},
+		},
+		{
+			name:        "unknown features are ignored",
+			text:        "ab",
+			facets:      []*vylet.RichtextFacet{facet(0, 2, &vylet.RichtextFacet_Features_Elem{})},
+			wantDropped: []string{DropNoFeatures},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, dropped := Normalize(context.Background(), &dir, tt.text, tt.facets)
+			if desc := describe(got); !slices.Equal(desc, tt.want) {
+				t.Fatalf("got facets %v, want %v", desc, tt.want)
+			}
+			if !slices.Equal(dropped, tt.wantDropped) {
+				t.Fatalf("got dropped %v, want %v", dropped, tt.wantDropped)
+			}
+		})
+	}
+}
+
+func TestNormalizeWithoutDirectory(t *testing.T) {
+	got, dropped := Normalize(context.Background(), nil, "ab", []*vylet.RichtextFacet{facet(0, 2, mention("did:plc:unknown"))})
+	if desc := describe(got); !slices.Equal(desc, []string{"0-2:mention=did:plc:unknown"}) || len(dropped) != 0 {
+		t.Fatalf("got %v dropped %v, want the mention kept without resolving it", desc, dropped)
+	}
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   string
+		wantOk bool
+	}{
+		{raw: "go", want: "go", wantOk: true},
+		{raw: "#Go", want: "go", wantOk: true},
+		{raw: "＃Photo", want: "photo", wantOk: true},
+		{raw: "  #spaced  ", want: "spaced", wantOk: true},
+		{raw: "ÉTÉ", want: "été", wantOk: true},
+		{raw: "", wantOk: false},
+		{raw: "#", wantOk: false},
+		{raw: "two words", wantOk: false},
+		{raw: string(make([]byte, maxTagLength+1)), wantOk: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeTag(tt.raw)
+		if got != tt.want || ok != tt.wantOk {
+			t.Fatalf("NormalizeTag(%q) = %q, %v, want %q, %v", tt.raw, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestNormalizeLink(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   string
+		wantOk bool
+	}{
+		{raw: "https://example.com", want: "https://example.com", wantOk: true},
+		{raw: "HTTP://EXAMPLE.com/Path?q=A", want: "http://example.com/Path?q=A", wantOk: true},
+		{raw: " https://example.com/ ", want: "https://example.com/", wantOk: true},
+		{raw: "example.com", wantOk: false},
+		{raw: "javascript:alert(1)", wantOk: false},
+		{raw: "ftp://example.com", wantOk: false},
+		{raw: "https://", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeLink(tt.raw)
+		if got != tt.want || ok != tt.wantOk {
+			t.Fatalf("NormalizeLink(%q) = %q, %v, want %q, %v", tt.raw, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package richtext
+
+import (
+	"strings"
+	"unicode"
+
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+// Maximum number of tags indexed for a single post, so that a post can't fan out into an unbounded number of tag
+// rows and counter updates
+const MaxTagsPerPost = 10
+
+// Returns the normalized tags used by a post, taken from its tag facets followed by any '#tags' in the text. Tags
+// are deduplicated and at most MaxTagsPerPost are returned.
+func ExtractTags(text string, facets []*vyletdatabase.Facet) []string {
+	seen := make(map[string]struct{})
+	var tags []string
+
+	add := func(raw string) {
+		if len(tags) >= MaxTagsPerPost {
+			return
+		}
+		tag, ok := NormalizeTag(raw)
+		if !ok {
+			return
+		}
+		if _, ok := seen[tag]; ok {
+			return
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+
+	for _, facet := range facets {
+		for _, feature := range facet.Features {
+			if tag, ok := feature.Feature.(*vyletdatabase.FacetFeature_Tag); ok {
+				add(tag.Tag)
+			}
+		}
+	}
+
+	for _, tag := range textTags(text) {
+		add(tag)
+	}
+
+	return tags
+}
+
+// Finds '#tags' in text. A tag starts with '#' or '＃' at the beginning of the text or after whitespace, runs until
+// the next whitespace, and has any trailing punctuation trimmed. Tags made up only of digits, such as "#1", aren't
+// considered tags.
+func textTags(text string) []string {
+	var tags []string
+
+	for _, word := range strings.Fields(text) {
+		var rest string
+		switch {
+		case strings.HasPrefix(word, "#"):
+			rest = strings.TrimPrefix(word, "#")
+		case strings.HasPrefix(word, "＃"):
+			rest = strings.TrimPrefix(word, "＃")
+		default:
+			continue
+		}
+
+		rest = strings.TrimRightFunc(rest, unicode.IsPunct)
+		if rest == "" || strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsDigit(r) }) == -1 {
+			continue
+		}
+
+		tags = append(tags, rest)
+	}
+
+	return tags
+}
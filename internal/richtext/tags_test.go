@@ -0,0 +1,57 @@
+package richtext
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	vyletdatabase "github.com/vylet-app/go/database/proto"
+)
+
+func tagFacet(tags ...string) *vyletdatabase.Facet {
+	features := make([]*vyletdatabase.FacetFeature, 0, len(tags))
+	for _, tag := range tags {
+		features = append(features, &vyletdatabase.FacetFeature{Feature: &vyletdatabase.FacetFeature_Tag{Tag: tag}})
+	}
+	return &vyletdatabase.Facet{Features: features}
+}
+
+func TestExtractTags(t *testing.T) {
+	var many []string
+	for i := range MaxTagsPerPost + 5 {
+		many = append(many, fmt.Sprintf("#tag%d", i))
+	}
+
+	tests := []struct {
+		name   string
+		text   string
+		facets []*vyletdatabase.Facet
+		want   []string
+	}{
+		{name: "none", text: "no tags here", want: nil},
+		{name: "text tags", text: "#Sunset at the #beach", want: []string{"sunset", "beach"}},
+		{name: "full width hash", text: "＃写真", want: []string{"写真"}},
+		{name: "trailing punctuation", text: "love #golang! and #rust.", want: []string{"golang", "rust"}},
+		{name: "only digits", text: "#1 #2024 #go2", want: []string{"go2"}},
+		{name: "not after whitespace", text: "a#b ##d", want: []string{"d"}},
+		{name: "facets first", text: "#text", facets: []*vyletdatabase.Facet{tagFacet("facet")}, want: []string{"facet", "text"}},
+		{name: "deduplicated", text: "#Go #go", facets: []*vyletdatabase.Facet{tagFacet("GO")}, want: []string{"go"}},
+		{name: "invalid facet tags", facets: []*vyletdatabase.Facet{tagFacet("", "ok")}, want: []string{"ok"}},
+		{name: "capped", text: strings.Join(many, " "), want: func() []string {
+			var want []string
+			for i := range MaxTagsPerPost {
+				want = append(want, fmt.Sprintf("tag%d", i))
+			}
+			return want
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractTags(tt.text, tt.facets); !slices.Equal(got, tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// Maximum number of terms indexed for a single document
+	MaxDocumentTerms = 256
+	// Maximum number of terms used from a query. Later terms are ignored.
+	MaxQueryTerms = 8
+	// Terms longer than this, in bytes, are ignored since they are almost never searched for
+	maxTermLength = 64
+)
+
+// A set of terms that keeps the order terms were first added in
+type termSet struct {
+	terms []string
+	seen  map[string]struct{}
+	max   int
+}
+
+func newTermSet(max int) *termSet {
+	return &termSet{
+		seen: make(map[string]struct{}),
+		max:  max,
+	}
+}
+
+func (ts *termSet) add(term string) {
+	if len(ts.terms) >= ts.max || len(term) > maxTermLength {
+		return
+	}
+	if _, ok := ts.seen[term]; ok {
+		return
+	}
+	ts.seen[term] = struct{}{}
+	ts.terms = append(ts.terms, term)
+}
+
+// Splits text into lowercase terms on anything that isn't a letter, mark, or digit
+func (ts *termSet) addText(text string) {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r)
+	})
+	for _, field := range fields {
+		ts.add(field)
+	}
+}
+
+// Returns the terms that a post is indexed under, from its caption, image alt text, and tags
+func PostTerms(caption string, altTexts []string, tags []string) []string {
+	ts := newTermSet(MaxDocumentTerms)
+	ts.addText(caption)
+	for _, alt := range altTexts {
+		ts.addText(alt)
+	}
+	for _, tag := range tags {
+		ts.addText(tag)
+	}
+	return ts.terms
+}
+
+// Returns the terms that an actor is indexed under. Handles are split into their labels like any other text, so a
+// search for "alice" matches "alice.vylet.app".
+func ActorTerms(handle, displayName, description string) []string {
+	ts := newTermSet(MaxDocumentTerms)
+	ts.addText(handle)
+	ts.addText(displayName)
+	ts.addText(description)
+	return ts.terms
+}
+
+// Returns the terms to search for from a query, up to MaxQueryTerms. Since each of a handle's labels is indexed, a
+// query for a full handle still matches on all of its labels.
+func QueryTerms(query string) []string {
+	ts := newTermSet(MaxQueryTerms)
+	ts.addText(query)
+	return ts.terms
+}
+
+// Picks the term to scan for when matching all of the given terms. Longer terms tend to be rarer, so scanning
+// the longest term means fewer candidates need to be checked against the others.
+func DriverTerm(terms []string) string {
+	var driver string
+	for _, term := range terms {
+		if len(term) > len(driver) {
+			driver = term
+		}
+	}
+	return driver
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Longest prefix, in runes, that actors are indexed under for typeahead. Queries longer than this are truncated,
+// which may return actors that only match the truncated query.
+const MaxTypeaheadPrefixLength = 20
+
+// Returns the prefixes that an actor is indexed under for typeahead. These are the prefixes of their full handle,
+// so that "alice.vy" matches, and of each word of their display name.
+func TypeaheadPrefixes(handle, displayName string) []string {
+	ts := newTermSet(MaxDocumentTerms)
+
+	addPrefixes := func(word string) {
+		for i := range word {
+			if i > 0 {
+				ts.add(word[:i])
+			}
+			if utf8.RuneCountInString(word[:i]) >= MaxTypeaheadPrefixLength {
+				return
+			}
+		}
+		ts.add(word)
+	}
+
+	addPrefixes(strings.ToLower(handle))
+
+	words := newTermSet(MaxDocumentTerms)
+	words.addText(displayName)
+	for _, word := range words.terms {
+		addPrefixes(word)
+	}
+
+	return ts.terms
+}
+
+// Returns the prefix to look up for a typeahead query, or an empty string if the query can't match anything. A
+// leading '@' is ignored, and only the first word of the query is used.
+func TypeaheadPrefix(query string) string {
+	query = strings.TrimPrefix(strings.TrimSpace(strings.ToLower(query)), "@")
+
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return ""
+	}
+	prefix := fields[0]
+
+	if utf8.RuneCountInString(prefix) > MaxTypeaheadPrefixLength {
+		prefix = string([]rune(prefix)[:MaxTypeaheadPrefixLength])
+	}
+
+	return prefix
+}
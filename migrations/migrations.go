@@ -0,0 +1,8 @@
+// Package migrations embeds the database's CQL migrations and their environment variables, so that binaries don't
+// need the migrations directory at runtime
+package migrations
+
+import "embed"
+
+//go:embed *.cql environments/*.json
+var FS embed.FS